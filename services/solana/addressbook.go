@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"solana/addressbook"
+	pb "solana/generated"
+)
+
+// addressBookServer implements the AddressBookService. User labels need a
+// database; known entities are listed without one.
+type addressBookServer struct {
+	pb.UnimplementedAddressBookServiceServer
+	book *addressbook.Book
+}
+
+func (a *addressBookServer) SetAddressLabel(ctx context.Context, req *pb.AddressLabel) (*pb.AddressLabel, error) {
+	if a.book.Store == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "address labels are not configured")
+	}
+	if err := validateSolanaAddress(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	entry := addressbook.Entry{
+		Address:  req.Address,
+		Label:    strings.TrimSpace(req.Label),
+		Category: strings.TrimSpace(req.Category),
+		Source:   addressbook.SourceUser,
+	}
+	if entry.Label == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "label is required")
+	}
+	if err := a.book.Store.SetLabel(ctx, entry); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return toPbAddressLabel(entry), nil
+}
+
+func (a *addressBookServer) DeleteAddressLabel(ctx context.Context, req *pb.DeleteAddressLabelRequest) (*pb.DeleteAddressLabelResponse, error) {
+	if a.book.Store == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "address labels are not configured")
+	}
+	if err := a.book.Store.DeleteLabel(ctx, req.Address); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &pb.DeleteAddressLabelResponse{}, nil
+}
+
+func (a *addressBookServer) ListAddressLabels(ctx context.Context, req *pb.ListAddressLabelsRequest) (*pb.ListAddressLabelsResponse, error) {
+	response := &pb.ListAddressLabelsResponse{}
+	if a.book.Store != nil {
+		labels, err := a.book.Store.Labels(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		for _, entry := range labels {
+			response.Labels = append(response.Labels, toPbAddressLabel(entry))
+		}
+	}
+	if req.IncludeKnown {
+		for _, entry := range a.book.Known.Entries() {
+			response.Labels = append(response.Labels, toPbAddressLabel(entry))
+		}
+	}
+	return response, nil
+}
+
+func toPbAddressLabel(entry addressbook.Entry) *pb.AddressLabel {
+	return &pb.AddressLabel{
+		Address:  entry.Address,
+		Label:    entry.Label,
+		Category: entry.Category,
+		Source:   entry.Source,
+	}
+}
@@ -0,0 +1,192 @@
+// Package addressbook labels addresses: user labels kept in Postgres, plus
+// known entities such as exchange deposit wallets, programs and bridges
+// loaded from a file.
+package addressbook
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/lib/pq"
+)
+
+// Sources of an entry.
+const (
+	SourceUser  = "user"
+	SourceKnown = "known"
+)
+
+// Entry labels an address. Category is free-form, e.g. "cex", "program",
+// "bridge" or "friend".
+type Entry struct {
+	Address  string `json:"address"`
+	Label    string `json:"name"`
+	Category string `json:"category"`
+	Source   string `json:"-"`
+}
+
+// Known is a fixed set of known entities keyed by address. A nil *Known is
+// empty.
+type Known struct {
+	entries map[string]Entry
+}
+
+// LoadKnown reads a JSON array of {"address", "name", "category"} objects.
+func LoadKnown(path string) (*Known, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read known entities: %w", err)
+	}
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse known entities: %w", err)
+	}
+	known := &Known{entries: make(map[string]Entry, len(entries))}
+	for _, entry := range entries {
+		entry.Source = SourceKnown
+		known.entries[entry.Address] = entry
+	}
+	return known, nil
+}
+
+// Lookup returns the known entity at an address.
+func (k *Known) Lookup(address string) (Entry, bool) {
+	if k == nil {
+		return Entry{}, false
+	}
+	entry, ok := k.entries[address]
+	return entry, ok
+}
+
+// Entries returns all known entities ordered by address.
+func (k *Known) Entries() []Entry {
+	if k == nil {
+		return nil
+	}
+	entries := make([]Entry, 0, len(k.entries))
+	for _, entry := range k.entries {
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Address < entries[j].Address })
+	return entries
+}
+
+// Len returns the number of known entities.
+func (k *Known) Len() int {
+	if k == nil {
+		return 0
+	}
+	return len(k.entries)
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS address_labels (
+	address    TEXT PRIMARY KEY,
+	label      TEXT NOT NULL,
+	category   TEXT NOT NULL DEFAULT '',
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);`
+
+// Store keeps user labels in Postgres.
+type Store struct {
+	db *sql.DB
+}
+
+// New creates the label table if needed and returns a store using db.
+func New(db *sql.DB) (*Store, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create address label table: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// SetLabel labels an address, replacing any previous label.
+func (s *Store) SetLabel(ctx context.Context, entry Entry) error {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO address_labels (address, label, category) VALUES ($1, $2, $3)
+		ON CONFLICT (address)
+		DO UPDATE SET label = EXCLUDED.label, category = EXCLUDED.category, updated_at = now()`,
+		entry.Address, entry.Label, entry.Category,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set label: %w", err)
+	}
+	return nil
+}
+
+// DeleteLabel removes the label of an address.
+func (s *Store) DeleteLabel(ctx context.Context, address string) error {
+	if _, err := s.db.ExecContext(ctx, `DELETE FROM address_labels WHERE address = $1`, address); err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+	return nil
+}
+
+// Labels returns every user label, ordered by address.
+func (s *Store) Labels(ctx context.Context) ([]Entry, error) {
+	return s.query(ctx, `SELECT address, label, category FROM address_labels ORDER BY address`)
+}
+
+// Lookup returns the user labels of the given addresses that have one.
+func (s *Store) Lookup(ctx context.Context, addresses []string) (map[string]Entry, error) {
+	entries, err := s.query(ctx,
+		`SELECT address, label, category FROM address_labels WHERE address = ANY($1)`, pq.Array(addresses))
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]Entry, len(entries))
+	for _, entry := range entries {
+		result[entry.Address] = entry
+	}
+	return result, nil
+}
+
+func (s *Store) query(ctx context.Context, query string, args ...interface{}) ([]Entry, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read labels: %w", err)
+	}
+	defer rows.Close()
+	var entries []Entry
+	for rows.Next() {
+		entry := Entry{Source: SourceUser}
+		if err := rows.Scan(&entry.Address, &entry.Label, &entry.Category); err != nil {
+			return nil, fmt.Errorf("failed to read label: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// Book combines user labels with known entities; user labels win. Either
+// part may be nil.
+type Book struct {
+	Known *Known
+	Store *Store
+}
+
+// Lookup returns the entries of the given addresses that are labelled.
+// Known entities are still returned when user labels cannot be read.
+func (b *Book) Lookup(ctx context.Context, addresses []string) (map[string]Entry, error) {
+	result := make(map[string]Entry)
+	if b == nil {
+		return result, nil
+	}
+	for _, address := range addresses {
+		if entry, ok := b.Known.Lookup(address); ok {
+			result[address] = entry
+		}
+	}
+	if b.Store == nil || len(addresses) == 0 {
+		return result, nil
+	}
+	labels, err := b.Store.Lookup(ctx, addresses)
+	for address, entry := range labels {
+		result[address] = entry
+	}
+	return result, err
+}
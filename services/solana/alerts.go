@@ -0,0 +1,324 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"solana/alerts"
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	solana_requests "solana/requests/solana"
+	"solana/safehttp"
+)
+
+// alertServer implements the AlertService on a rule store and the alert
+// stream. Without a database the store is nil and only the stream, which
+// also carries transfer notifications, is served.
+type alertServer struct {
+	pb.UnimplementedAlertServiceServer
+	store  *alerts.Store
+	stream *alerts.Broadcaster
+	// webhooks is false when no webhook secret is configured, and email
+	// when no SMTP server is, so rules using them are refused.
+	webhooks bool
+	email    bool
+}
+
+// errAlertsDisabled is returned by the rule RPCs when no database is
+// configured.
+var errAlertsDisabled = status.Errorf(codes.FailedPrecondition, "alert rules are not configured")
+
+func (a *alertServer) CreateAlertRule(ctx context.Context, req *pb.AlertRule) (*pb.AlertRule, error) {
+	if a.store == nil {
+		return nil, errAlertsDisabled
+	}
+	rule := alerts.Rule{
+		Kind:       req.Kind,
+		Mint:       strings.TrimSpace(req.Mint),
+		Threshold:  req.Threshold,
+		WebhookURL: strings.TrimSpace(req.WebhookUrl),
+		Email:      strings.TrimSpace(req.Email),
+		Cooldown:   time.Duration(req.CooldownSeconds) * time.Second,
+		Enabled:    !req.Disabled,
+	}
+	if !alerts.ValidKind(rule.Kind) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown alert kind %q", rule.Kind)
+	}
+	if rule.Kind != alerts.KindWalletValueBelow {
+		if err := validateSolanaAddress(rule.Mint); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid mint: %v", err)
+		}
+	}
+	if rule.Kind == alerts.KindWalletValueBelow || rule.Kind == alerts.KindPnlAbove || rule.Kind == alerts.KindPnlBelow {
+		wallet, err := resolveWalletAddress(req.WalletAddress)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid wallet address: %v", err)
+		}
+		rule.Wallet = wallet
+	}
+	if req.CooldownSeconds < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "cooldown must not be negative")
+	}
+	if rule.WebhookURL != "" {
+		if !a.webhooks {
+			return nil, status.Errorf(codes.FailedPrecondition, "webhooks are not configured")
+		}
+		if err := safehttp.ValidateURL(rule.WebhookURL); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid webhook url: %v", err)
+		}
+	}
+	if rule.Email != "" {
+		if !a.email {
+			return nil, status.Errorf(codes.FailedPrecondition, "email is not configured")
+		}
+		address, err := mail.ParseAddress(rule.Email)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid email: %v", err)
+		}
+		rule.Email = address.Address
+	}
+	rule, err := a.store.CreateRule(ctx, rule)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return toPbAlertRule(rule), nil
+}
+
+func (a *alertServer) DeleteAlertRule(ctx context.Context, req *pb.DeleteAlertRuleRequest) (*pb.DeleteAlertRuleResponse, error) {
+	if a.store == nil {
+		return nil, errAlertsDisabled
+	}
+	if err := a.store.DeleteRule(ctx, req.Id); err != nil {
+		if errors.Is(err, alerts.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &pb.DeleteAlertRuleResponse{}, nil
+}
+
+func (a *alertServer) ListAlertRules(ctx context.Context, req *pb.ListAlertRulesRequest) (*pb.ListAlertRulesResponse, error) {
+	if a.store == nil {
+		return nil, errAlertsDisabled
+	}
+	rules, err := a.store.Rules(ctx, false)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	response := &pb.ListAlertRulesResponse{}
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, toPbAlertRule(rule))
+	}
+	return response, nil
+}
+
+func (a *alertServer) WatchAlerts(req *pb.WatchAlertsRequest, stream pb.AlertService_WatchAlertsServer) error {
+	wanted := make(map[int64]bool)
+	for _, id := range req.RuleIds {
+		wanted[id] = true
+	}
+	alertsCh, stop := a.stream.Subscribe()
+	defer stop()
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case alert := <-alertsCh:
+			if len(wanted) > 0 && !wanted[alert.RuleID] {
+				continue
+			}
+			if err := stream.Send(toPbAlertEvent(alert)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toPbAlertRule(rule alerts.Rule) *pb.AlertRule {
+	result := &pb.AlertRule{
+		Id:              rule.ID,
+		Kind:            rule.Kind,
+		Mint:            rule.Mint,
+		WalletAddress:   rule.Wallet,
+		Threshold:       rule.Threshold,
+		WebhookUrl:      rule.WebhookURL,
+		Email:           rule.Email,
+		CooldownSeconds: int64(rule.Cooldown / time.Second),
+		Disabled:        !rule.Enabled,
+		CreatedAt:       rule.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if !rule.LastFiredAt.IsZero() {
+		result.LastFiredAt = rule.LastFiredAt.UTC().Format(time.RFC3339)
+	}
+	return result
+}
+
+func toPbAlertEvent(alert alerts.Alert) *pb.AlertEvent {
+	return &pb.AlertEvent{
+		RuleId:        alert.RuleID,
+		Kind:          alert.Kind,
+		Mint:          alert.Mint,
+		WalletAddress: alert.Wallet,
+		Threshold:     alert.Threshold,
+		Value:         alert.Value,
+		Message:       alert.Message,
+		FiredAt:       alert.FiredAt.UTC().Format(time.RFC3339),
+		Signature:     alert.Signature,
+		Amount:        alert.Amount,
+		Symbol:        alert.Symbol,
+		Counterparty:  alert.Counterparty,
+	}
+}
+
+// alertSource evaluates alert rules with the same price sources the wallet
+// RPCs use.
+type alertSource struct {
+	server *server
+}
+
+func (a alertSource) TokenPrices(ctx context.Context, mints []string) (map[string]float64, error) {
+	raw, err := coingecko_requests.GetCoinGeckoTokenPrices(mints)
+	if err != nil {
+		return nil, err
+	}
+	prices := make(map[string]float64, len(raw))
+	for mint, value := range raw {
+		if price, err := strconv.ParseFloat(value, 64); err == nil {
+			prices[mint] = price
+		}
+	}
+	return prices, nil
+}
+
+// PriceChange24h compares the open of the oldest hourly candle of the last
+// 24 hours with the close of the newest, on the token's best pool.
+func (a alertSource) PriceChange24h(ctx context.Context, mint string) (float64, error) {
+	pools, err := coingecko_requests.RankTokenPools(mint)
+	if err != nil {
+		return 0, err
+	}
+	pool := bestPool(pools)
+	if pool == "" {
+		return 0, fmt.Errorf("no pools for %s", mint)
+	}
+	hourly, _ := coingecko_requests.ParseResolution("1h")
+	candles, err := coingecko_requests.GetOHLCVS(pool, hourly, time.Now().Add(-24*time.Hour).Unix(), 0)
+	if err != nil {
+		return 0, err
+	}
+	if len(candles) == 0 {
+		return 0, fmt.Errorf("no price history for %s", mint)
+	}
+	// Candles are newest first.
+	open := candles[len(candles)-1][1]
+	closing := candles[0][4]
+	if open == 0 {
+		return 0, fmt.Errorf("no opening price for %s", mint)
+	}
+	return (closing - open) / open * 100, nil
+}
+
+// WalletValue values a wallet's SOL and fungible tokens at current prices.
+// Staking, positions and NFTs are left out to keep scheduled checks cheap.
+func (a alertSource) WalletValue(ctx context.Context, wallet string) (float64, error) {
+	info, err := solana_requests.RequestAccountInfo(wallet)
+	if err != nil {
+		return 0, err
+	}
+	solanaPrice, err := coingecko_requests.GetSolanaPrice()
+	if err != nil {
+		return 0, err
+	}
+	accounts, err := solana_requests.RequestTokenAccounts(wallet)
+	if err != nil {
+		return 0, err
+	}
+	fungible, _ := splitNftAccounts(accounts.Result.Value)
+	var mints []string
+	for _, account := range fungible {
+		mints = append(mints, account.Account.Data.Parsed.Info.Mint)
+	}
+	prices := map[string]float64{}
+	if len(mints) > 0 {
+		if prices, err = a.TokenPrices(ctx, mints); err != nil {
+			return 0, err
+		}
+	}
+	value := info.SolAmount * solanaPrice
+	for _, account := range fungible {
+		info := account.Account.Data.Parsed.Info
+		value += info.TokenAmount.UIAmount * prices[info.Mint]
+	}
+	return value, nil
+}
+
+// TokenPnL builds the wallet's token the way AddWallet does and returns its PnL.
+func (a alertSource) TokenPnL(ctx context.Context, wallet, mint string) (float64, error) {
+	accounts, err := solana_requests.RequestTokenAccounts(wallet)
+	if err != nil {
+		return 0, err
+	}
+	prices, err := coingecko_requests.GetCoinGeckoTokenPrices([]string{mint})
+	if err != nil {
+		return 0, err
+	}
+	solanaPrice, err := coingecko_requests.GetSolanaPrice()
+	if err != nil {
+		return 0, err
+	}
+	for _, account := range accounts.Result.Value {
+		if account.Account.Data.Parsed.Info.Mint != mint {
+			continue
+		}
+		token, err := a.server.buildToken(account, prices, tokenOptions{
+			resolution:  coingecko_requests.DefaultResolution,
+			solanaPrice: solanaPrice,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return token.Pnl, nil
+	}
+	return 0, fmt.Errorf("wallet %s holds no %s", wallet, mint)
+}
+
+// newNotifier configures alert delivery from the environment:
+// PULSE_ALERT_WEBHOOK_SECRET to enable webhooks and, to enable email,
+// PULSE_SMTP_ADDR with PULSE_SMTP_USER, PULSE_SMTP_PASSWORD and
+// PULSE_SMTP_FROM.
+func newNotifier() *alerts.Notifier {
+	notifier := &alerts.Notifier{Stream: alerts.NewBroadcaster()}
+	if secret := os.Getenv("PULSE_ALERT_WEBHOOK_SECRET"); secret != "" {
+		notifier.Webhook = alerts.NewWebhook(secret)
+	} else {
+		log.Warn("PULSE_ALERT_WEBHOOK_SECRET is not set; webhooks are disabled")
+	}
+	if addr := os.Getenv("PULSE_SMTP_ADDR"); addr != "" {
+		notifier.Mailer = alerts.NewMailer(addr,
+			os.Getenv("PULSE_SMTP_USER"),
+			os.Getenv("PULSE_SMTP_PASSWORD"),
+			envOrDefault("PULSE_SMTP_FROM", "pulse@localhost"),
+		)
+	}
+	return notifier
+}
+
+// newAlertScheduler configures rule evaluation from the environment:
+// PULSE_ALERT_INTERVAL (default 1m).
+func newAlertScheduler(store *alerts.Store, wallets *server, notifier *alerts.Notifier) (*alerts.Scheduler, error) {
+	interval, err := time.ParseDuration(envOrDefault("PULSE_ALERT_INTERVAL", "1m"))
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid PULSE_ALERT_INTERVAL: %q", os.Getenv("PULSE_ALERT_INTERVAL"))
+	}
+	return alerts.NewScheduler(store, alertSource{server: wallets}, notifier, interval), nil
+}
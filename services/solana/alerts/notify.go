@@ -0,0 +1,170 @@
+package alerts
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"solana/safehttp"
+)
+
+// SignatureHeader carries the HMAC-SHA256 of a webhook body, hex encoded
+// and prefixed with "sha256=".
+const SignatureHeader = "X-Pulse-Signature"
+
+// Notifier delivers alerts to the stream and to webhooks and email. Nil
+// senders are skipped.
+type Notifier struct {
+	Webhook *Webhook
+	Mailer  *Mailer
+	Stream  *Broadcaster
+}
+
+// Deliver publishes an alert to the stream and sends it to webhookURL and
+// email when set. Delivery failures are logged; the alert still counts as
+// delivered so it is not repeated.
+func (n *Notifier) Deliver(ctx context.Context, alert Alert, webhookURL, email string) {
+	n.Stream.Publish(alert)
+	if webhookURL != "" && n.Webhook != nil {
+		if err := n.Webhook.Send(ctx, webhookURL, alert); err != nil {
+			log.Warn("failed to deliver alert webhook", "rule", alert.RuleID, "kind", alert.Kind, "error", err)
+		}
+	}
+	if email != "" && n.Mailer != nil {
+		if err := n.Mailer.Send(email, alert); err != nil {
+			log.Warn("failed to deliver alert email", "rule", alert.RuleID, "kind", alert.Kind, "error", err)
+		}
+	}
+}
+
+// Webhook posts alerts as JSON, signed with a shared secret so receivers
+// can verify they came from Pulse.
+type Webhook struct {
+	secret []byte
+	client *http.Client
+}
+
+// NewWebhook returns a webhook sender signing with secret. It only posts to
+// public addresses, since webhook URLs come from users.
+func NewWebhook(secret string) *Webhook {
+	return &Webhook{secret: []byte(secret), client: safehttp.NewClient(10 * time.Second)}
+}
+
+// Send posts an alert to url.
+func (w *Webhook) Send(ctx context.Context, url string, alert Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, "sha256="+Sign(w.secret, body))
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of body.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Mailer sends alerts by SMTP.
+type Mailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewMailer returns a mailer for the SMTP server at addr (host:port). Auth
+// is skipped when user is empty.
+func NewMailer(addr, user, password, from string) *Mailer {
+	mailer := &Mailer{addr: addr, from: from}
+	if user != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		mailer.auth = smtp.PlainAuth("", user, password, host)
+	}
+	return mailer
+}
+
+// Send emails an alert to one recipient.
+func (m *Mailer) Send(to string, alert Alert) error {
+	if strings.ContainsAny(to, "\r\n") {
+		return fmt.Errorf("invalid recipient %q", to)
+	}
+	message := strings.Join([]string{
+		"From: " + m.from,
+		"To: " + to,
+		"Subject: Pulse alert: " + alert.Kind,
+		"Date: " + alert.FiredAt.Format(time.RFC1123Z),
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		alert.Message,
+		"",
+	}, "\r\n")
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{to}, []byte(message))
+}
+
+// Broadcaster fans alerts out to stream subscribers. A nil *Broadcaster
+// drops alerts.
+type Broadcaster struct {
+	mu          sync.Mutex
+	subscribers map[chan Alert]bool
+}
+
+// NewBroadcaster returns a broadcaster without subscribers.
+func NewBroadcaster() *Broadcaster {
+	return &Broadcaster{subscribers: make(map[chan Alert]bool)}
+}
+
+// Subscribe returns a channel receiving alerts and a function to stop.
+func (b *Broadcaster) Subscribe() (<-chan Alert, func()) {
+	ch := make(chan Alert, 16)
+	b.mu.Lock()
+	b.subscribers[ch] = true
+	b.mu.Unlock()
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subscribers, ch)
+		b.mu.Unlock()
+	}
+}
+
+// Publish sends an alert to every subscriber. Subscribers that are not
+// keeping up miss it rather than blocking evaluation.
+func (b *Broadcaster) Publish(alert Alert) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subscribers {
+		select {
+		case ch <- alert:
+		default:
+		}
+	}
+}
@@ -0,0 +1,164 @@
+// Package alerts evaluates user-defined price and portfolio rules on a
+// schedule and delivers the alerts they fire, and transfer notifications,
+// by webhook, email and stream.
+package alerts
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNotFound is returned for rules that do not exist.
+var ErrNotFound = errors.New("alert rule not found")
+
+// Rule kinds. Price and 24h change rules watch a mint; wallet value rules a
+// wallet; PnL rules a mint held by a wallet.
+const (
+	KindPriceAbove       = "price_above"
+	KindPriceBelow       = "price_below"
+	KindChange24hAbove   = "change_24h_above"
+	KindWalletValueBelow = "wallet_value_below"
+	KindPnlAbove         = "pnl_above"
+	KindPnlBelow         = "pnl_below"
+)
+
+// ValidKind reports whether kind is a known rule kind.
+func ValidKind(kind string) bool {
+	switch kind {
+	case KindPriceAbove, KindPriceBelow, KindChange24hAbove, KindWalletValueBelow, KindPnlAbove, KindPnlBelow:
+		return true
+	}
+	return false
+}
+
+// Transfer notification kinds. They are emitted for the transfers of
+// watched wallets rather than evaluated from rules.
+const (
+	KindTransferIn  = "transfer_in"
+	KindTransferOut = "transfer_out"
+)
+
+// Rule is an alert condition and where to deliver it. A rule fires when
+// its condition becomes true, not while it stays true, and no sooner than
+// Cooldown after it last fired.
+type Rule struct {
+	ID        int64
+	Kind      string
+	Mint      string
+	Wallet    string
+	Threshold float64
+	// WebhookURL and Email are optional; stream subscribers always get alerts.
+	WebhookURL string
+	Email      string
+	Cooldown   time.Duration
+	Enabled    bool
+	CreatedAt  time.Time
+	// Active records whether the condition held at the last evaluation.
+	Active      bool
+	LastFiredAt time.Time
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS alert_rules (
+	id               BIGSERIAL PRIMARY KEY,
+	kind             TEXT NOT NULL,
+	mint             TEXT NOT NULL DEFAULT '',
+	wallet           TEXT NOT NULL DEFAULT '',
+	threshold        DOUBLE PRECISION NOT NULL,
+	webhook_url      TEXT NOT NULL DEFAULT '',
+	email            TEXT NOT NULL DEFAULT '',
+	cooldown_seconds BIGINT NOT NULL DEFAULT 0,
+	enabled          BOOLEAN NOT NULL DEFAULT TRUE,
+	active           BOOLEAN NOT NULL DEFAULT FALSE,
+	last_fired_at    TIMESTAMPTZ,
+	created_at       TIMESTAMPTZ NOT NULL DEFAULT now()
+);`
+
+const ruleColumns = `id, kind, mint, wallet, threshold, webhook_url, email, cooldown_seconds, enabled, active, last_fired_at, created_at`
+
+// Store keeps alert rules and their evaluation state in Postgres.
+type Store struct {
+	db *sql.DB
+}
+
+// New creates the rule table if needed and returns a store using db.
+func New(db *sql.DB) (*Store, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create alert rule table: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// CreateRule saves a new rule and returns it with its id.
+func (s *Store) CreateRule(ctx context.Context, rule Rule) (Rule, error) {
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO alert_rules (kind, mint, wallet, threshold, webhook_url, email, cooldown_seconds, enabled)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at`,
+		rule.Kind, rule.Mint, rule.Wallet, rule.Threshold, rule.WebhookURL, rule.Email,
+		int64(rule.Cooldown/time.Second), rule.Enabled,
+	).Scan(&rule.ID, &rule.CreatedAt)
+	if err != nil {
+		return Rule{}, fmt.Errorf("failed to create alert rule: %w", err)
+	}
+	return rule, nil
+}
+
+// DeleteRule deletes a rule.
+func (s *Store) DeleteRule(ctx context.Context, id int64) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM alert_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete alert rule: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Rules returns all rules, or only the enabled ones, ordered by id.
+func (s *Store) Rules(ctx context.Context, enabledOnly bool) ([]Rule, error) {
+	query := `SELECT ` + ruleColumns + ` FROM alert_rules`
+	if enabledOnly {
+		query += ` WHERE enabled`
+	}
+	rows, err := s.db.QueryContext(ctx, query+` ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alert rules: %w", err)
+	}
+	defer rows.Close()
+	var rules []Rule
+	for rows.Next() {
+		var rule Rule
+		var cooldown int64
+		var lastFired sql.NullTime
+		err := rows.Scan(&rule.ID, &rule.Kind, &rule.Mint, &rule.Wallet, &rule.Threshold,
+			&rule.WebhookURL, &rule.Email, &cooldown, &rule.Enabled, &rule.Active, &lastFired, &rule.CreatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read alert rule: %w", err)
+		}
+		rule.Cooldown = time.Duration(cooldown) * time.Second
+		rule.LastFiredAt = lastFired.Time
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+// SetActive records whether a rule's condition held, and when it fired if
+// it did just now.
+func (s *Store) SetActive(ctx context.Context, id int64, active bool, firedAt time.Time) error {
+	var fired sql.NullTime
+	if !firedAt.IsZero() {
+		fired = sql.NullTime{Time: firedAt, Valid: true}
+	}
+	_, err := s.db.ExecContext(ctx, `
+		UPDATE alert_rules SET active = $2, last_fired_at = COALESCE($3, last_fired_at)
+		WHERE id = $1`, id, active, fired)
+	if err != nil {
+		return fmt.Errorf("failed to update alert rule: %w", err)
+	}
+	return nil
+}
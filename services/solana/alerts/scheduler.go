@@ -0,0 +1,173 @@
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/log"
+)
+
+// Source provides the values rules are evaluated against.
+type Source interface {
+	TokenPrices(ctx context.Context, mints []string) (map[string]float64, error)
+	// PriceChange24h returns a mint's price change over the last 24 hours
+	// in percent.
+	PriceChange24h(ctx context.Context, mint string) (float64, error)
+	WalletValue(ctx context.Context, wallet string) (float64, error)
+	TokenPnL(ctx context.Context, wallet, mint string) (float64, error)
+}
+
+// Alert is a fired rule or a transfer notification. Transfers have no rule;
+// their Value is the transfer's USD value and Threshold the minimum value
+// notified.
+type Alert struct {
+	RuleID    int64     `json:"rule_id"`
+	Kind      string    `json:"kind"`
+	Mint      string    `json:"mint,omitempty"`
+	Wallet    string    `json:"wallet,omitempty"`
+	Threshold float64   `json:"threshold"`
+	Value     float64   `json:"value"`
+	Message   string    `json:"message"`
+	FiredAt   time.Time `json:"fired_at"`
+
+	Signature    string  `json:"signature,omitempty"`
+	Amount       float64 `json:"amount,omitempty"`
+	Symbol       string  `json:"symbol,omitempty"`
+	Counterparty string  `json:"counterparty,omitempty"`
+}
+
+// Scheduler periodically evaluates the enabled rules and delivers the
+// alerts they fire.
+type Scheduler struct {
+	store    *Store
+	source   Source
+	interval time.Duration
+	notifier *Notifier
+}
+
+// NewScheduler returns a scheduler evaluating rules every interval and
+// delivering alerts through notifier.
+func NewScheduler(store *Store, source Source, notifier *Notifier, interval time.Duration) *Scheduler {
+	return &Scheduler{store: store, source: source, notifier: notifier, interval: interval}
+}
+
+// Run evaluates rules until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		s.Evaluate(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate checks every enabled rule once. Prices are fetched in one batch
+// for all price rules.
+func (s *Scheduler) Evaluate(ctx context.Context) {
+	rules, err := s.store.Rules(ctx, true)
+	if err != nil {
+		log.Error("failed to load alert rules", "error", err)
+		return
+	}
+	var mints []string
+	for _, rule := range rules {
+		if rule.Kind == KindPriceAbove || rule.Kind == KindPriceBelow {
+			mints = append(mints, rule.Mint)
+		}
+	}
+	var prices map[string]float64
+	if len(mints) > 0 {
+		if prices, err = s.source.TokenPrices(ctx, mints); err != nil {
+			log.Warn("failed to fetch prices for alerts", "error", err)
+		}
+	}
+	now := time.Now().UTC()
+	for _, rule := range rules {
+		value, err := s.observe(ctx, rule, prices)
+		if err != nil {
+			log.Warn("failed to evaluate alert rule", "rule", rule.ID, "error", err)
+			continue
+		}
+		holds := condition(rule, value)
+		// Fire on the transition into the condition, once the cooldown
+		// since the last alert has passed. A rule only becomes active when
+		// it fires, so a condition that starts holding during the cooldown
+		// still fires once the cooldown is over.
+		fire := holds && !rule.Active && now.Sub(rule.LastFiredAt) >= rule.Cooldown
+		active := holds && (rule.Active || fire)
+		var firedAt time.Time
+		if fire {
+			firedAt = now
+			s.notifier.Deliver(ctx, newAlert(rule, value, now), rule.WebhookURL, rule.Email)
+		}
+		if active != rule.Active || fire {
+			if err := s.store.SetActive(ctx, rule.ID, active, firedAt); err != nil {
+				log.Error("failed to save alert rule state", "rule", rule.ID, "error", err)
+			}
+		}
+	}
+}
+
+func (s *Scheduler) observe(ctx context.Context, rule Rule, prices map[string]float64) (float64, error) {
+	switch rule.Kind {
+	case KindPriceAbove, KindPriceBelow:
+		price, ok := prices[rule.Mint]
+		if !ok {
+			return 0, fmt.Errorf("no price for %s", rule.Mint)
+		}
+		return price, nil
+	case KindChange24hAbove:
+		return s.source.PriceChange24h(ctx, rule.Mint)
+	case KindWalletValueBelow:
+		return s.source.WalletValue(ctx, rule.Wallet)
+	case KindPnlAbove, KindPnlBelow:
+		return s.source.TokenPnL(ctx, rule.Wallet, rule.Mint)
+	}
+	return 0, fmt.Errorf("unknown rule kind %q", rule.Kind)
+}
+
+// condition reports whether a rule's condition holds for an observed value.
+// The 24h change rule compares the size of the move in either direction.
+func condition(rule Rule, value float64) bool {
+	switch rule.Kind {
+	case KindPriceAbove, KindPnlAbove:
+		return value > rule.Threshold
+	case KindPriceBelow, KindPnlBelow, KindWalletValueBelow:
+		return value < rule.Threshold
+	case KindChange24hAbove:
+		return value > rule.Threshold || value < -rule.Threshold
+	}
+	return false
+}
+
+func newAlert(rule Rule, value float64, now time.Time) Alert {
+	alert := Alert{
+		RuleID:    rule.ID,
+		Kind:      rule.Kind,
+		Mint:      rule.Mint,
+		Wallet:    rule.Wallet,
+		Threshold: rule.Threshold,
+		Value:     value,
+		FiredAt:   now,
+	}
+	switch rule.Kind {
+	case KindPriceAbove:
+		alert.Message = fmt.Sprintf("%s price %.6g is above %.6g", rule.Mint, value, rule.Threshold)
+	case KindPriceBelow:
+		alert.Message = fmt.Sprintf("%s price %.6g is below %.6g", rule.Mint, value, rule.Threshold)
+	case KindChange24hAbove:
+		alert.Message = fmt.Sprintf("%s moved %.2f%% in 24h, more than %.2f%%", rule.Mint, value, rule.Threshold)
+	case KindWalletValueBelow:
+		alert.Message = fmt.Sprintf("wallet %s value %.2f is below %.2f", rule.Wallet, value, rule.Threshold)
+	case KindPnlAbove:
+		alert.Message = fmt.Sprintf("%s PnL in %s is %.2f, above %.2f", rule.Mint, rule.Wallet, value, rule.Threshold)
+	case KindPnlBelow:
+		alert.Message = fmt.Sprintf("%s PnL in %s is %.2f, below %.2f", rule.Mint, rule.Wallet, value, rule.Threshold)
+	}
+	return alert
+}
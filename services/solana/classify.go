@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/charmbracelet/log"
+
+	"solana/addressbook"
+	pb "solana/generated"
+	"solana/income"
+	solana_requests "solana/requests/solana"
+	"solana/taxreport"
+)
+
+// classifiedTransaction is one wallet's side of a transaction. Sent and
+// received amounts are positive and exclude the fee, which is reported on
+// its own.
+type classifiedTransaction struct {
+	signature string
+	blockTime int64
+	wallet    string
+	// kind is one of the taxreport entry kinds.
+	kind     string
+	sent     []transfer
+	received []transfer
+	// fee is the SOL fee the wallet paid as fee payer.
+	fee          float64
+	counterparty string
+	// counterparties are every other account whose balance changed.
+	counterparties []string
+	// peers maps each asset sent or received to the account that took or
+	// gave the most of it.
+	peers map[string]string
+	// programs are the programs of the top-level instructions.
+	programs []string
+}
+
+// depositCategories are the address book categories of senders whose
+// transfers are the user's own funds arriving rather than airdrops: an
+// exchange account or a bridge.
+var depositCategories = map[string]bool{"cex": true, "bridge": true}
+
+// classifyTransaction classifies a transaction from a wallet's balance
+// changes, counting wrapped SOL as SOL. Sending and receiving makes a
+// trade; only sending a withdrawal and only receiving a deposit, or an
+// airdrop when tokens other than SOL were received from no owned wallet.
+// Either is a transfer when every counterparty is one of the owned
+// wallets. Wrapping or unwrapping SOL changes nothing but the fee.
+func classifyTransaction(tx *pb.Transaction, wallet string, ownedWallets map[string]bool) classifiedTransaction {
+	classified := classifiedTransaction{
+		signature: transactionSignature(tx),
+		blockTime: tx.GetResult().GetBlockTime(),
+		wallet:    wallet,
+		programs:  invokedPrograms(tx),
+	}
+	if keys := tx.GetResult().GetTransaction().GetMessage().GetAccountKeys(); len(keys) > 0 && keys[0] == wallet {
+		classified.fee = float64(tx.Result.Meta.GetFee()) / lamportsPerSol
+	}
+	for _, t := range foldWrappedSol(walletTransfers(tx, wallet)) {
+		if t.amount > 0 {
+			classified.received = append(classified.received, t)
+		} else {
+			classified.sent = append(classified.sent, transfer{mint: t.mint, amount: -t.amount})
+		}
+	}
+
+	deltas := ownerDeltas(tx)
+	classified.peers = make(map[string]string)
+	for _, t := range classified.sent {
+		classified.peers[t.mint] = peer(deltas, wallet, t.mint, 1)
+	}
+	for _, t := range classified.received {
+		classified.peers[t.mint] = peer(deltas, wallet, t.mint, -1)
+	}
+
+	internal, fromOwned := false, false
+	others := counterparties(tx, map[string]bool{wallet: true})
+	classified.counterparties = others
+	for _, other := range others {
+		if ownedWallets[other] {
+			fromOwned = true
+		} else if classified.counterparty == "" {
+			classified.counterparty = other
+		}
+	}
+	if classified.counterparty == "" && len(others) > 0 {
+		internal = true
+		classified.counterparty = others[0]
+	}
+
+	switch {
+	case len(classified.sent) > 0 && len(classified.received) > 0:
+		classified.kind = taxreport.KindTrade
+	case len(classified.sent) == 0 && len(classified.received) == 0:
+		classified.kind = taxreport.KindFee
+	case internal:
+		classified.kind = taxreport.KindTransfer
+	case len(classified.received) > 0 && receivedTokens(classified.received) && !fromOwned:
+		classified.kind = taxreport.KindAirdrop
+	case len(classified.received) > 0:
+		classified.kind = taxreport.KindDeposit
+	default:
+		classified.kind = taxreport.KindWithdrawal
+	}
+	return classified
+}
+
+// peer returns the account other than wallet whose balance of mint changed
+// the most in the direction of sign, or "" when none did.
+func peer(deltas map[string]map[string]float64, wallet, mint string, sign float64) string {
+	var found string
+	var most float64
+	for _, account := range sortedKeys(deltas) {
+		if amount := sign * deltas[account][mint]; account != wallet && amount > most {
+			found, most = account, amount
+		}
+	}
+	return found
+}
+
+// receivedTokens reports whether any received asset is a token other than
+// SOL.
+func receivedTokens(received []transfer) bool {
+	for _, t := range received {
+		if t.mint != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// foldWrappedSol adds wrapped SOL to SOL, so that both share one set of
+// lots. Moving SOL into wrapped SOL or back and nothing else is wrapping
+// or unwrapping, which returns no transfers; the rent of a token account
+// opened or closed to do so is left out with them.
+func foldWrappedSol(transfers []transfer) []transfer {
+	var sol, wrapped float64
+	var movedSol, movedWrapped bool
+	var tokens []transfer
+	for _, t := range transfers {
+		switch t.mint {
+		case "":
+			sol += t.amount
+			movedSol = true
+		case wrappedSolMint:
+			wrapped += t.amount
+			movedWrapped = true
+		default:
+			tokens = append(tokens, t)
+		}
+	}
+	if !movedWrapped {
+		return transfers
+	}
+	if movedSol && len(tokens) == 0 && (sol > 0) != (wrapped > 0) {
+		return nil
+	}
+	if total := sol + wrapped; total != 0 {
+		tokens = append([]transfer{{amount: total}}, tokens...)
+	}
+	return tokens
+}
+
+// treatmentKinds maps the treatments users give received assets to the
+// kind of the transaction receiving them.
+var treatmentKinds = map[string]string{
+	income.TreatmentIncome:  taxreport.KindIncome,
+	income.TreatmentGift:    taxreport.KindGift,
+	income.TreatmentSpam:    taxreport.KindSpam,
+	income.TreatmentDeposit: taxreport.KindDeposit,
+}
+
+// applyTreatment reclassifies a deposit or airdrop by the user's treatment
+// of the transaction, or else of the first received mint that has one.
+func applyTreatment(classified *classifiedTransaction, treatments income.Treatments) {
+	if classified.kind != taxreport.KindAirdrop && classified.kind != taxreport.KindDeposit {
+		return
+	}
+	for _, t := range classified.received {
+		if treatment := treatments.For(classified.signature, classified.wallet, t.mint); treatment != "" {
+			classified.kind = treatmentKinds[treatment]
+			return
+		}
+	}
+}
+
+// applyTreatments reclassifies airdrops sent by an exchange or a bridge,
+// going by the address book, as deposits, then applies the user's
+// treatments. A failed address book lookup leaves airdrops as they are.
+func (s *server) applyTreatments(ctx context.Context, classified []classifiedTransaction, treatments income.Treatments) {
+	var senders []string
+	for _, transaction := range classified {
+		if transaction.kind == taxreport.KindAirdrop {
+			senders = append(senders, transaction.counterparties...)
+		}
+	}
+	var labels map[string]addressbook.Entry
+	if len(senders) > 0 {
+		var err error
+		labels, err = s.addressBook.Lookup(ctx, senders)
+		if err != nil {
+			log.Warn("failed to look up airdrop senders", "error", err)
+		}
+	}
+	for i := range classified {
+		transaction := &classified[i]
+		if transaction.kind == taxreport.KindAirdrop {
+			for _, sender := range transaction.counterparties {
+				if depositCategories[labels[sender].Category] {
+					transaction.kind = taxreport.KindDeposit
+					break
+				}
+			}
+		}
+		applyTreatment(transaction, treatments)
+	}
+}
+
+// classifyWalletHistory classifies the wallets' transactions with a block
+// time in [start, end), oldest first, applying the address book and the
+// user's treatments of received assets. Failed transactions are included
+// since their fee was still charged. It fails when a wallet's history
+// cannot be fetched, and reports truncated when a wallet has more of it
+// than can be paged through, so its oldest transactions are missing.
+func (s *server) classifyWalletHistory(ctx context.Context, wallets []string, treatments income.Treatments, start, end int64) (classified []classifiedTransaction, truncated bool, err error) {
+	owned := make(map[string]bool)
+	for _, wallet := range wallets {
+		owned[wallet] = true
+	}
+	for _, wallet := range wallets {
+		hashes, complete, err := solana_requests.GetTransactionHashesBetween(wallet, start, end)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list transactions of %s: %w", wallet, err)
+		}
+		if !complete {
+			log.Warn("transaction history truncated; oldest transactions are missing", "wallet", wallet, "signatures", len(hashes))
+			truncated = true
+		}
+		signatures := make([]string, len(hashes))
+		for i, hash := range hashes {
+			signatures[i] = hash.Signature
+		}
+		err = fetchTransactions(ctx, signatures, func(tx *pb.Transaction) {
+			classified = append(classified, classifyTransaction(tx, wallet, owned))
+		})
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	s.applyTreatments(ctx, classified, treatments)
+	sort.SliceStable(classified, func(i, j int) bool {
+		return classified[i].blockTime < classified[j].blockTime
+	})
+	return classified, truncated, nil
+}
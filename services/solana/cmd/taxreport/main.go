@@ -0,0 +1,141 @@
+// Command taxreport exports a tax report CSV, or with -gains a capital
+// gains report, from a running wallet server.
+//
+//	taxreport -wallet <address> [-wallet <address>...] [-portfolio <id>]
+//	    [-format koinly|cointracker|ledger] [-from 2024-01-01] [-to 2024-12-31]
+//	    [-o report.csv] [-addr localhost:50051]
+//	taxreport -gains -wallet <address> [-year 2024] [-year-start 04-06]
+//	    [-long-term-days 365] [-format text|csv|json] [-o report.txt]
+//
+// The report is written to the file the server suggests unless -o names
+// one; -o - writes it to stdout.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "solana/generated"
+)
+
+// walletFlags collects repeated or comma separated -wallet flags.
+type walletFlags []string
+
+func (w *walletFlags) String() string {
+	return strings.Join(*w, ",")
+}
+
+func (w *walletFlags) Set(value string) error {
+	for _, wallet := range strings.Split(value, ",") {
+		if wallet = strings.TrimSpace(wallet); wallet != "" {
+			*w = append(*w, wallet)
+		}
+	}
+	return nil
+}
+
+func main() {
+	var wallets walletFlags
+	flag.Var(&wallets, "wallet", "wallet address or .sol domain; repeatable")
+	portfolio := flag.Int64("portfolio", 0, "saved portfolio whose owned wallets are included")
+	format := flag.String("format", "", "koinly (default), cointracker or ledger; with -gains text (default), csv or json")
+	from := flag.String("from", "", "first day, YYYY-MM-DD (default start of this year)")
+	to := flag.String("to", "", "last day, YYYY-MM-DD (default today)")
+	output := flag.String("o", "", "output file, - for stdout (default the suggested name)")
+	addr := flag.String("addr", "localhost:50051", "wallet server address")
+	timeout := flag.Duration("timeout", 30*time.Minute, "how long to wait for the report")
+	gains := flag.Bool("gains", false, "report capital gains and income for a tax year")
+	year := flag.Int("year", 0, "with -gains, the tax year (default this year)")
+	yearStart := flag.String("year-start", "", "with -gains, first day of the tax year as MM-DD (default 01-01)")
+	longTermDays := flag.Int("long-term-days", 0, "with -gains, days held after which gains are long term (default 365)")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to connect to %s: %v", *addr, err)
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	client := pb.NewWalletServiceClient(conn)
+
+	var data []byte
+	var filename, summary string
+	if *gains {
+		data, filename, summary, err = capitalGains(ctx, client, &pb.CapitalGainsRequest{
+			WalletAddresses: wallets,
+			PortfolioId:     *portfolio,
+			TaxYear:         int32(*year),
+			YearStart:       *yearStart,
+			LongTermDays:    int32(*longTermDays),
+			Format:          *format,
+		})
+	} else {
+		if *format == "" {
+			*format = "koinly"
+		}
+		var report *pb.TaxReport
+		report, err = client.ExportTaxReport(ctx, &pb.TaxReportRequest{
+			WalletAddresses: wallets,
+			PortfolioId:     *portfolio,
+			Format:          *format,
+			StartDate:       *from,
+			EndDate:         *to,
+		})
+		if report != nil {
+			data, filename, summary = report.Csv, report.Filename, fmt.Sprintf("%d rows", report.Rows)
+		}
+	}
+	if err != nil {
+		log.Fatalf("failed to export report: %v", err)
+	}
+
+	path := *output
+	if path == "" {
+		path = filename
+	}
+	if path == "-" {
+		_, err = os.Stdout.Write(data)
+	} else {
+		err = os.WriteFile(path, data, 0o644)
+	}
+	if err != nil {
+		log.Fatalf("failed to write report: %v", err)
+	}
+	if path != "-" {
+		log.Info("wrote report", "file", path, "summary", summary)
+	}
+}
+
+// capitalGains fetches a capital gains report rendered as text or CSV by
+// the server, or as the report message's JSON.
+func capitalGains(ctx context.Context, client pb.WalletServiceClient, req *pb.CapitalGainsRequest) ([]byte, string, string, error) {
+	format := req.Format
+	if format == "" {
+		format = "text"
+	}
+	if format == "json" {
+		req.Format = ""
+	} else {
+		req.Format = format
+	}
+	report, err := client.GetCapitalGains(ctx, req)
+	if err != nil {
+		return nil, "", "", err
+	}
+	summary := fmt.Sprintf("%d disposals, %d income items", len(report.Disposals), len(report.Income))
+	if format != "json" {
+		return report.Report, report.Filename, summary, nil
+	}
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(report)
+	return data, fmt.Sprintf("pulse-gains-%d.json", report.TaxYear), summary, err
+}
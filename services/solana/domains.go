@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/log"
+
+	pb "solana/generated"
+	solana_requests "solana/requests/solana"
+)
+
+// resolveWalletAddress accepts a base58 address or a .sol domain and
+// returns the wallet address.
+func resolveWalletAddress(input string) (string, error) {
+	if strings.HasSuffix(strings.ToLower(input), ".sol") {
+		return solana_requests.ResolveDomain(input)
+	}
+	return input, validateSolanaAddress(input)
+}
+
+// primaryDomain returns the primary .sol domain of a wallet, or an empty
+// string when it has none.
+func primaryDomain(wallet string) string {
+	domains, err := solana_requests.ReverseLookup([]string{wallet})
+	if err != nil {
+		log.Warn("failed to look up primary domain", "wallet", wallet, "error", err)
+	}
+	return domains[wallet]
+}
+
+// annotateCounterparties sets the counterparties and invoked programs of
+// each transaction, then labels them from the address book and looks up
+// the counterparties' primary domains, each in one batch.
+func (s *server) annotateCounterparties(ctx context.Context, transactions []*pb.Transaction, ownedWallets map[string]bool) {
+	seen := make(map[string]bool)
+	var wallets, addresses []string
+	for _, tx := range transactions {
+		tx.Counterparties = nil
+		for _, address := range counterparties(tx, ownedWallets) {
+			tx.Counterparties = append(tx.Counterparties, &pb.Counterparty{Address: address})
+			if !seen[address] {
+				seen[address] = true
+				wallets = append(wallets, address)
+				addresses = append(addresses, address)
+			}
+		}
+		tx.Programs = nil
+		for _, address := range invokedPrograms(tx) {
+			tx.Programs = append(tx.Programs, &pb.Counterparty{Address: address})
+			if !seen[address] {
+				seen[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	if len(addresses) == 0 {
+		return
+	}
+	labels, err := s.addressBook.Lookup(ctx, addresses)
+	if err != nil {
+		log.Warn("failed to look up address labels", "error", err)
+	}
+	var domains map[string]string
+	if len(wallets) > 0 {
+		domains, err = solana_requests.ReverseLookup(wallets)
+		if err != nil {
+			log.Warn("failed to look up counterparty domains", "error", err)
+		}
+	}
+	annotate := func(counterparty *pb.Counterparty) {
+		counterparty.Domain = domains[counterparty.Address]
+		if entry, ok := labels[counterparty.Address]; ok {
+			counterparty.Label = entry.Label
+			counterparty.Category = entry.Category
+			counterparty.LabelSource = entry.Source
+		}
+	}
+	for _, tx := range transactions {
+		for _, counterparty := range tx.Counterparties {
+			annotate(counterparty)
+		}
+		for _, program := range tx.Programs {
+			annotate(program)
+		}
+	}
+}
+
+// invokedPrograms returns the distinct programs of a transaction's
+// top-level instructions.
+func invokedPrograms(tx *pb.Transaction) []string {
+	if tx.Result == nil || tx.Result.Transaction == nil || tx.Result.Transaction.Message == nil {
+		return nil
+	}
+	msg := tx.Result.Transaction.Message
+	seen := make(map[string]bool)
+	var programs []string
+	for _, instr := range msg.Instructions {
+		if int(instr.ProgramIdIndex) >= len(msg.AccountKeys) {
+			continue
+		}
+		program := msg.AccountKeys[instr.ProgramIdIndex]
+		if !seen[program] {
+			seen[program] = true
+			programs = append(programs, program)
+		}
+	}
+	return programs
+}
+
+// counterparties returns the accounts, other than the owned wallets, whose
+// SOL balance changed in a transaction, plus the owners of token accounts
+// whose balance changed. Fee payers are included since paying the fee
+// changes their balance.
+func counterparties(tx *pb.Transaction, ownedWallets map[string]bool) []string {
+	if tx.Result == nil || tx.Result.Meta == nil || tx.Result.Transaction == nil || tx.Result.Transaction.Message == nil {
+		return nil
+	}
+	meta := tx.Result.Meta
+	keys := tx.Result.Transaction.Message.AccountKeys
+	seen := make(map[string]bool)
+	var result []string
+	add := func(address string) {
+		if address != "" && !ownedWallets[address] && !seen[address] {
+			seen[address] = true
+			result = append(result, address)
+		}
+	}
+
+	// Token accounts whose balance changed are represented by their owner.
+	tokenAccounts := make(map[uint32]bool)
+	preTokens := make(map[uint32]string)
+	for _, balance := range meta.PreTokenBalances {
+		tokenAccounts[balance.AccountIndex] = true
+		if balance.UiTokenAmount != nil {
+			preTokens[balance.AccountIndex] = balance.UiTokenAmount.Amount
+		}
+	}
+	for _, balance := range meta.PostTokenBalances {
+		tokenAccounts[balance.AccountIndex] = true
+		var post string
+		if balance.UiTokenAmount != nil {
+			post = balance.UiTokenAmount.Amount
+		}
+		if post != preTokens[balance.AccountIndex] {
+			add(balance.Owner)
+		}
+	}
+
+	for i, key := range keys {
+		if i >= len(meta.PreBalances) || i >= len(meta.PostBalances) || tokenAccounts[uint32(i)] {
+			continue
+		}
+		if meta.PreBalances[i] != meta.PostBalances[i] {
+			add(key)
+		}
+	}
+	return result
+}
+
+// ownerDeltas returns how a transaction changed the balances of every
+// account, per asset: SOL for plain accounts and tokens for the owners of
+// token accounts, with wrapped SOL counted as SOL. Token accounts' own SOL
+// is left out, so wrapped SOL is not counted twice. A failed transaction
+// moved nothing but its fee and has no deltas.
+func ownerDeltas(tx *pb.Transaction) map[string]map[string]float64 {
+	if tx.Result == nil || tx.Result.Meta == nil || tx.Result.Transaction == nil || tx.Result.Transaction.Message == nil || transactionFailed(tx) {
+		return nil
+	}
+	meta := tx.Result.Meta
+	deltas := make(map[string]map[string]float64)
+	add := func(owner, mint string, amount float64) {
+		if mint == wrappedSolMint {
+			mint = ""
+		}
+		if deltas[owner] == nil {
+			deltas[owner] = make(map[string]float64)
+		}
+		deltas[owner][mint] += amount
+	}
+	tokenAccounts := make(map[uint32]bool)
+	for _, balance := range meta.PreTokenBalances {
+		tokenAccounts[balance.AccountIndex] = true
+		if balance.UiTokenAmount != nil {
+			add(balance.Owner, balance.Mint, -balance.UiTokenAmount.UiAmount)
+		}
+	}
+	for _, balance := range meta.PostTokenBalances {
+		tokenAccounts[balance.AccountIndex] = true
+		if balance.UiTokenAmount != nil {
+			add(balance.Owner, balance.Mint, balance.UiTokenAmount.UiAmount)
+		}
+	}
+	for i, key := range tx.Result.Transaction.Message.AccountKeys {
+		if i >= len(meta.PreBalances) || i >= len(meta.PostBalances) || tokenAccounts[uint32(i)] {
+			continue
+		}
+		if lamports := int64(meta.PostBalances[i]) - int64(meta.PreBalances[i]); lamports != 0 {
+			add(key, "", float64(lamports)/lamportsPerSol)
+		}
+	}
+	return deltas
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pb "solana/generated"
+	solana_types "solana/types/solana_rpc"
+)
+
+// tokenProgramErrors names the custom errors of the SPL Token program,
+// which Token-2022 shares.
+var tokenProgramErrors = []string{
+	"lamport balance below rent-exempt threshold",
+	"insufficient funds",
+	"invalid mint",
+	"account not associated with this mint",
+	"owner does not match",
+	"fixed supply",
+	"account already in use",
+	"invalid number of provided signers",
+	"invalid number of required signers",
+	"state is uninitialized",
+	"instruction does not support native tokens",
+	"non-native account can only be closed if its balance is zero",
+	"invalid instruction",
+	"state is invalid for requested operation",
+	"operation overflowed",
+	"account does not support specified authority type",
+	"this token mint cannot freeze accounts",
+	"account is frozen",
+	"the provided decimals value different from the mint decimals",
+	"instruction does not support non-native tokens",
+}
+
+var tokenPrograms = map[string]bool{
+	"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA": true,
+	"TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb": true,
+}
+
+// transactionFailed reports whether a fetched transaction failed. Failed
+// transactions changed no balances other than charging their fee.
+func transactionFailed(tx *pb.Transaction) bool {
+	return tx.GetResult().GetMeta().GetStatus().GetErrorMessage() != ""
+}
+
+// transactionStatus turns the err of a transaction's meta, nil when it
+// succeeded, into its status.
+func transactionStatus(err interface{}) *pb.Status {
+	if err == nil {
+		return &pb.Status{Result: &pb.Status_Ok{}}
+	}
+	return &pb.Status{Result: &pb.Status_ErrorMessage{ErrorMessage: describeTransactionError(err)}}
+}
+
+// describeTransactionError describes a TransactionError as returned by the
+// RPC, e.g. "InsufficientFundsForFee" or
+// {"InstructionError":[2,{"Custom":6001}]}.
+func describeTransactionError(err interface{}) string {
+	switch value := err.(type) {
+	case string:
+		return value
+	case map[string]interface{}:
+		if instruction, ok := value["InstructionError"].([]interface{}); ok && len(instruction) == 2 {
+			index, _ := instruction[0].(float64)
+			return fmt.Sprintf("instruction %d: %s", int(index), describeInstructionError(instruction[1]))
+		}
+		if names := sortedKeys(value); len(names) > 0 {
+			return names[0]
+		}
+	}
+	encoded, _ := json.Marshal(err)
+	return string(encoded)
+}
+
+// describeInstructionError describes an InstructionError, either a name
+// such as "InvalidAccountData" or {"Custom": code}.
+func describeInstructionError(err interface{}) string {
+	if custom, ok := err.(map[string]interface{}); ok {
+		if code, ok := custom["Custom"].(float64); ok {
+			return fmt.Sprintf("custom program error %d", int64(code))
+		}
+	}
+	return describeTransactionError(err)
+}
+
+// programFailure reads which program failed a transaction and why from its
+// log messages. Anchor error messages and programs' own "Error:" logs are
+// preferred over the runtime's summary, and SPL Token custom error codes
+// are named. It returns empty strings when the logs say nothing.
+func programFailure(logs []string) (program, reason string) {
+	var logged string
+	for _, line := range logs {
+		if message, ok := strings.CutPrefix(line, "Program log: "); ok {
+			if _, anchorMessage, found := strings.Cut(message, "Error Message: "); found && strings.HasPrefix(message, "AnchorError") {
+				logged = strings.TrimSuffix(anchorMessage, ".")
+			} else if errorMessage, found := strings.CutPrefix(message, "Error: "); found {
+				logged = errorMessage
+			}
+			continue
+		}
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) < 3 || fields[0] != "Program" {
+			continue
+		}
+		failure, failed := strings.CutPrefix(fields[2], "failed: ")
+		if !failed {
+			continue
+		}
+		program, reason = fields[1], failure
+		if hex, ok := strings.CutPrefix(failure, "custom program error: 0x"); ok {
+			if code, err := strconv.ParseUint(hex, 16, 32); err == nil {
+				reason = fmt.Sprintf("custom program error %d", code)
+				if tokenPrograms[program] && code < uint64(len(tokenProgramErrors)) {
+					reason = tokenProgramErrors[code]
+				}
+			}
+		}
+		if logged != "" {
+			reason = logged
+		}
+		return program, reason
+	}
+	return "", logged
+}
+
+// applyFailure marks a fetched transaction that failed and decodes why.
+func applyFailure(tx *pb.Transaction) {
+	if !transactionFailed(tx) {
+		return
+	}
+	tx.Failed = true
+	tx.FailedProgram, tx.FailureReason = programFailure(tx.Result.Meta.LogMessages)
+	if tx.FailureReason == "" {
+		tx.FailureReason = tx.Result.Meta.Status.GetErrorMessage()
+	}
+}
+
+// toPbFailedTransaction reports a fetched transaction that failed.
+func toPbFailedTransaction(tx *pb.Transaction) *pb.FailedTransaction {
+	return &pb.FailedTransaction{
+		Signature: transactionSignature(tx),
+		BlockTime: tx.Result.BlockTime,
+		Slot:      tx.Result.Slot,
+		Fee:       float64(tx.Result.Meta.GetFee()) / lamportsPerSol,
+		Program:   tx.FailedProgram,
+		Reason:    tx.FailureReason,
+	}
+}
+
+// signatureFailure reports a failed transaction from its signature alone,
+// without its logs or fee.
+func signatureFailure(hash solana_types.WalletTransactionHashResponse) *pb.FailedTransaction {
+	return &pb.FailedTransaction{
+		Signature: hash.Signature,
+		BlockTime: hash.BlockTime,
+		Slot:      uint64(hash.Slot),
+		Reason:    describeTransactionError(*hash.Err),
+	}
+}
+
+// sortFailures orders failed transactions newest first.
+func sortFailures(failures []*pb.FailedTransaction) {
+	sort.SliceStable(failures, func(i, j int) bool {
+		return failures[i].Slot > failures[j].Slot
+	})
+}
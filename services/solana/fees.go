@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/mr-tron/base58"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"solana/fees"
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	solana_requests "solana/requests/solana"
+)
+
+const defaultFeeResolution = "1d"
+
+// GetFeeReport totals what each wallet paid for the transactions it was
+// the fee payer of over the range, and buckets it by the resolution. Fees
+// are valued at the SOL price when they were paid.
+func (s *server) GetFeeReport(ctx context.Context, req *pb.FeeReportRequest) (*pb.FeeReport, error) {
+	wallets, err := s.requestedWallets(ctx, req.WalletAddresses, req.PortfolioId, false)
+	if err != nil {
+		return nil, err
+	}
+	historyRange, window, err := historyWindow(req.HistoryRange)
+	if err != nil {
+		return nil, err
+	}
+	historyResolution := req.HistoryResolution
+	if historyResolution == "" {
+		historyResolution = defaultFeeResolution
+	}
+	resolution, err := coingecko_requests.ParseResolution(historyResolution)
+	if err != nil || resolution.Timeframe == "minute" {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported history resolution %q", historyResolution)
+	}
+	step := time.Duration(resolution.Seconds()) * time.Second
+	if window/step > maxHistoryPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "%s at %s is more than %d buckets", historyRange, historyResolution, maxHistoryPoints)
+	}
+	end := time.Now()
+	start := end.Add(-window)
+
+	prices := newPriceHistory(start.Unix(), end.Unix())
+	response := &pb.FeeReport{}
+	var all []fees.Transaction
+	for _, wallet := range wallets {
+		paid, failures, err := walletFees(ctx, wallet, start.Unix(), prices)
+		if err != nil {
+			return nil, transactionsError(err)
+		}
+		all = append(all, paid...)
+		summary := fees.Summarise(paid, start, end, step)
+		report := &pb.WalletFees{Wallet: wallet, Totals: toPbFeeTotals(summary.Totals), Failures: failures}
+		for _, bucket := range summary.Buckets {
+			report.Buckets = append(report.Buckets, &pb.FeeBucket{
+				Timestamp: int32(bucket.Start.Unix()),
+				Totals:    toPbFeeTotals(bucket.Totals),
+			})
+		}
+		response.Wallets = append(response.Wallets, report)
+	}
+	// Only the fee payer pays, so no transaction is counted twice.
+	response.Totals = toPbFeeTotals(fees.Summarise(all, start, end, step).Totals)
+	return response, nil
+}
+
+// walletFees returns what a wallet paid for the transactions since a unix
+// time that it was the fee payer of, and those of them that failed. Failed
+// transactions are fetched too since the signature list has no fees.
+func walletFees(ctx context.Context, wallet string, since int64, prices *priceHistory) ([]fees.Transaction, []*pb.FailedTransaction, error) {
+	hashes, complete, err := solana_requests.GetTransactionHashesSince(wallet, since)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !complete {
+		log.Warn("transaction history truncated; fees are understated", "wallet", wallet, "signatures", len(hashes))
+	}
+	failed := make(map[string]bool)
+	var signatures []string
+	for _, hash := range hashes {
+		signatures = append(signatures, hash.Signature)
+		failed[hash.Signature] = hash.Err != nil
+	}
+	var paid []fees.Transaction
+	var failures []*pb.FailedTransaction
+	err = fetchTransactions(ctx, signatures, func(tx *pb.Transaction) {
+		if fee, ok := transactionFee(tx, wallet); ok {
+			fee.Failed = failed[transactionSignature(tx)] || transactionFailed(tx)
+			fee.SolPrice = prices.price("", tx.Result.BlockTime)
+			paid = append(paid, fee)
+			if fee.Failed {
+				failures = append(failures, toPbFailedTransaction(tx))
+			}
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	sortFailures(failures)
+	return paid, failures, nil
+}
+
+// transactionFee splits the fee of a transaction the wallet paid for into
+// its parts, reading the priority fee from the ComputeBudget instructions
+// and tips from what Jito tip accounts received. It reports false when the
+// wallet was not the fee payer.
+func transactionFee(tx *pb.Transaction, wallet string) (fees.Transaction, bool) {
+	message := tx.GetResult().GetTransaction().GetMessage()
+	keys := message.GetAccountKeys()
+	if len(keys) == 0 || keys[0] != wallet || tx.Result.Meta == nil {
+		return fees.Transaction{}, false
+	}
+	var budget fees.Budget
+	instructions := 0
+	for _, instruction := range message.Instructions {
+		if int(instruction.ProgramIdIndex) >= len(keys) || keys[instruction.ProgramIdIndex] != fees.ComputeBudgetProgram {
+			instructions++
+			continue
+		}
+		if data, err := base58.Decode(instruction.Data); err == nil {
+			budget.Apply(data)
+		}
+	}
+	meta := tx.Result.Meta
+	var tip uint64
+	for i, key := range keys {
+		if fees.IsTipAccount(key) && i < len(meta.PreBalances) && i < len(meta.PostBalances) && meta.PostBalances[i] > meta.PreBalances[i] {
+			tip += meta.PostBalances[i] - meta.PreBalances[i]
+		}
+	}
+	return fees.Transaction{
+		Time:         time.Unix(tx.Result.BlockTime, 0),
+		Fee:          meta.Fee,
+		PriorityFee:  budget.PriorityFee(instructions),
+		Tip:          tip,
+		ComputeUnits: meta.ComputeUnitsConsumed,
+		UnitPrice:    budget.UnitPrice,
+	}, true
+}
+
+func toPbFeeTotals(totals fees.Totals) *pb.FeeTotals {
+	amount := func(a fees.Amount) *pb.FeeAmount {
+		return &pb.FeeAmount{Sol: a.Sol, Usd: a.USD}
+	}
+	return &pb.FeeTotals{
+		Transactions:       int32(totals.Transactions),
+		FailedTransactions: int32(totals.Failed),
+		Base:               amount(totals.Base),
+		Priority:           amount(totals.Priority),
+		Tips:               amount(totals.Tips),
+		FailedCost:         amount(totals.FailedCost),
+		Total:              amount(totals.Total),
+		Compute: &pb.ComputeUsage{
+			Transactions:  int32(totals.Compute.Count),
+			Total:         totals.Compute.Total,
+			Mean:          totals.Compute.Mean,
+			Min:           totals.Compute.Min,
+			Median:        totals.Compute.Median,
+			P90:           totals.Compute.P90,
+			Max:           totals.Compute.Max,
+			MeanUnitPrice: totals.Compute.MeanUnitPrice,
+		},
+	}
+}
@@ -0,0 +1,243 @@
+// Package fees splits what a wallet paid to get its transactions included
+// into base fees, priority fees and Jito tips, and summarises them and the
+// compute the transactions used over time.
+package fees
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+	"time"
+)
+
+// ComputeBudgetProgram is the program whose instructions set a
+// transaction's compute unit limit and price.
+const ComputeBudgetProgram = "ComputeBudget111111111111111111111111111111"
+
+const (
+	// defaultUnitsPerInstruction is the compute unit limit each instruction
+	// gets when the transaction does not set one.
+	defaultUnitsPerInstruction = 200_000
+	// maxComputeUnits is the most compute a transaction can request.
+	maxComputeUnits = 1_400_000
+	// microLamportsPerLamport scales compute unit prices.
+	microLamportsPerLamport = 1_000_000
+	// LamportsPerSol converts lamports to SOL.
+	LamportsPerSol = 1e9
+)
+
+// ComputeBudget instruction discriminators.
+const (
+	requestUnitsDeprecated = 0
+	setComputeUnitLimit    = 2
+	setComputeUnitPrice    = 3
+)
+
+// tipAccounts are the Jito tip payment accounts. Tips are plain transfers
+// to one of them.
+var tipAccounts = map[string]bool{
+	"96gYZGLnJYVFmbjzopPSU6QiEV5fGqZNyN9nmNhvrZU5": true,
+	"HFqU5x63VTqvQss8hp11i4wVV8bD44PvwucfZ2bU7gRe": true,
+	"Cw8CFyM9FkoMi7K7Crf6HNQqf4uEMzpKw6QNghXLvLkY": true,
+	"ADaUMid9yfUytqMBgopwjb2DTLSokTSzL1zt6iGPaS49": true,
+	"DfXygSm4jCyNCybVYYK6DwvWqjKee8pbDmJGcLWNDXjh": true,
+	"ADuUkR4vqLUMWXxW9gh6D6L8pMSawimctcNZ5pGwDcEt": true,
+	"DttWaMuVvTiduZRnguLF7jNxTgiMBZ1hyAumKUiL2KRL": true,
+	"3AVi9Tg9Uo68tJfuvoKvqKNWKkC5wPdSSdeBnizKZ6jT": true,
+}
+
+// IsTipAccount reports whether address is a Jito tip account.
+func IsTipAccount(address string) bool {
+	return tipAccounts[address]
+}
+
+// Budget is what a transaction's ComputeBudget instructions requested.
+type Budget struct {
+	// UnitLimit is 0 when the transaction did not set one.
+	UnitLimit uint32
+	// UnitPrice is in micro-lamports per compute unit.
+	UnitPrice uint64
+	// AdditionalFee is the lamports offered by the deprecated RequestUnits
+	// instruction.
+	AdditionalFee uint32
+}
+
+// Apply adds one ComputeBudget instruction's data to the budget. Other
+// instructions, such as the heap frame request, are ignored.
+func (b *Budget) Apply(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	switch data[0] {
+	case requestUnitsDeprecated:
+		if len(data) >= 9 {
+			b.UnitLimit = binary.LittleEndian.Uint32(data[1:5])
+			b.AdditionalFee = binary.LittleEndian.Uint32(data[5:9])
+		}
+	case setComputeUnitLimit:
+		if len(data) >= 5 {
+			b.UnitLimit = binary.LittleEndian.Uint32(data[1:5])
+		}
+	case setComputeUnitPrice:
+		if len(data) >= 9 {
+			b.UnitPrice = binary.LittleEndian.Uint64(data[1:9])
+		}
+	}
+}
+
+// PriorityFee returns the lamports the budget offers on top of the base
+// fee. instructions is the number of top-level instructions other than
+// ComputeBudget ones, which sets the limit when the budget does not.
+func (b Budget) PriorityFee(instructions int) uint64 {
+	if b.AdditionalFee > 0 {
+		return uint64(b.AdditionalFee)
+	}
+	limit := uint64(b.UnitLimit)
+	if limit == 0 {
+		limit = min(uint64(instructions)*defaultUnitsPerInstruction, maxComputeUnits)
+	}
+	return uint64(math.Ceil(float64(limit) * float64(b.UnitPrice) / microLamportsPerLamport))
+}
+
+// Transaction is what a wallet paid for one transaction it was the fee
+// payer of. Amounts are in lamports; SolPrice values them in USD.
+type Transaction struct {
+	Time   time.Time
+	Failed bool
+	// Fee is the whole fee charged, priority fee included.
+	Fee          uint64
+	PriorityFee  uint64
+	Tip          uint64
+	ComputeUnits uint64
+	// UnitPrice is in micro-lamports per compute unit.
+	UnitPrice uint64
+	SolPrice  float64
+}
+
+// Amount is an amount of SOL and its USD value when it was paid.
+type Amount struct {
+	Sol float64
+	USD float64
+}
+
+func (a *Amount) add(lamports uint64, solPrice float64) {
+	sol := float64(lamports) / LamportsPerSol
+	a.Sol += sol
+	a.USD += sol * solPrice
+}
+
+// Distribution describes a set of compute unit readings.
+type Distribution struct {
+	Count                 int
+	Total                 uint64
+	Mean                  float64
+	Min, Median, P90, Max uint64
+	// MeanUnitPrice is the mean compute unit price, in micro-lamports, of
+	// the transactions that set one.
+	MeanUnitPrice float64
+
+	units                   []uint64
+	pricedCount, priceTotal uint64
+}
+
+func (d *Distribution) add(tx Transaction) {
+	d.units = append(d.units, tx.ComputeUnits)
+	d.Total += tx.ComputeUnits
+	if tx.UnitPrice > 0 {
+		d.pricedCount++
+		d.priceTotal += tx.UnitPrice
+	}
+}
+
+// finish computes the statistics from the readings added.
+func (d *Distribution) finish() {
+	d.Count = len(d.units)
+	if d.Count == 0 {
+		return
+	}
+	sort.Slice(d.units, func(i, j int) bool { return d.units[i] < d.units[j] })
+	d.Mean = float64(d.Total) / float64(d.Count)
+	d.Min = d.units[0]
+	d.Median = percentile(d.units, 0.5)
+	d.P90 = percentile(d.units, 0.9)
+	d.Max = d.units[d.Count-1]
+	if d.pricedCount > 0 {
+		d.MeanUnitPrice = float64(d.priceTotal) / float64(d.pricedCount)
+	}
+	d.units = nil
+}
+
+// percentile returns the nearest-rank percentile of sorted readings.
+func percentile(sorted []uint64, p float64) uint64 {
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
+
+// Totals are the fees paid for a set of transactions. Base, Priority and
+// Tips add up to Total; FailedCost is the part of Total paid for the
+// Failed transactions, which are charged their fees all the same.
+type Totals struct {
+	Transactions int
+	Failed       int
+	Base         Amount
+	Priority     Amount
+	Tips         Amount
+	FailedCost   Amount
+	Total        Amount
+	Compute      Distribution
+}
+
+func (t *Totals) add(tx Transaction) {
+	t.Transactions++
+	priority := min(tx.PriorityFee, tx.Fee)
+	t.Base.add(tx.Fee-priority, tx.SolPrice)
+	t.Priority.add(priority, tx.SolPrice)
+	t.Tips.add(tx.Tip, tx.SolPrice)
+	t.Total.add(tx.Fee+tx.Tip, tx.SolPrice)
+	if tx.Failed {
+		t.Failed++
+		t.FailedCost.add(tx.Fee+tx.Tip, tx.SolPrice)
+	}
+	t.Compute.add(tx)
+}
+
+// Bucket is the totals of the transactions in [Start, Start+step).
+type Bucket struct {
+	Start time.Time
+	Totals
+}
+
+// Summary is the fees paid over a period, in total and per bucket.
+type Summary struct {
+	Totals
+	Buckets []Bucket
+}
+
+// Summarise totals transactions in [start, end) and per step from start.
+// Buckets without transactions are left out.
+func Summarise(transactions []Transaction, start, end time.Time, step time.Duration) Summary {
+	var summary Summary
+	buckets := make(map[int64]*Bucket)
+	for _, tx := range transactions {
+		if tx.Time.Before(start) || !tx.Time.Before(end) {
+			continue
+		}
+		summary.add(tx)
+		index := int64(tx.Time.Sub(start) / step)
+		bucket, ok := buckets[index]
+		if !ok {
+			bucket = &Bucket{Start: start.Add(time.Duration(index) * step)}
+			buckets[index] = bucket
+		}
+		bucket.add(tx)
+	}
+	summary.Compute.finish()
+	for _, bucket := range buckets {
+		bucket.Compute.finish()
+		summary.Buckets = append(summary.Buckets, *bucket)
+	}
+	sort.Slice(summary.Buckets, func(i, j int) bool {
+		return summary.Buckets[i].Start.Before(summary.Buckets[j].Start)
+	})
+	return summary
+}
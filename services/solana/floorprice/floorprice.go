@@ -0,0 +1,44 @@
+// Package floorprice looks up NFT collection floor prices. Sources are
+// pluggable so marketplaces can be swapped without touching valuation.
+package floorprice
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Source returns the floor price of a collection in SOL. Collections the
+// source knows nothing about yield ok == false rather than an error.
+type Source interface {
+	FloorPrice(collection string) (price float64, ok bool, err error)
+}
+
+// StaticSource serves floor prices from a fixed map of collection mint to
+// SOL, e.g. maintained by hand or exported from a marketplace.
+type StaticSource map[string]float64
+
+// LoadStatic reads a JSON object mapping collection mints to floor prices in SOL.
+func LoadStatic(path string) (StaticSource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read floor prices: %w", err)
+	}
+	var source StaticSource
+	if err := json.Unmarshal(data, &source); err != nil {
+		return nil, fmt.Errorf("failed to parse floor prices: %w", err)
+	}
+	return source, nil
+}
+
+func (s StaticSource) FloorPrice(collection string) (float64, bool, error) {
+	price, ok := s[collection]
+	return price, ok, nil
+}
+
+// None is a Source without any prices, used when nothing is configured.
+type None struct{}
+
+func (None) FloorPrice(string) (float64, bool, error) {
+	return 0, false, nil
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"solana/flowgraph"
+	pb "solana/generated"
+	"solana/income"
+	solana_requests "solana/requests/solana"
+	"solana/taxreport"
+)
+
+const (
+	maxFlowDepth = 2
+	// flowExpandSignatures caps the transactions fetched per expanded
+	// address; exchanges and programs have far more history than can be
+	// fetched.
+	flowExpandSignatures = 100
+	// maxFlowExpansions bounds the addresses expanded per hop, the ones
+	// with the most value flowing through them first.
+	maxFlowExpansions = 20
+)
+
+// flowKinds are the kinds of transaction drawn as flows: funds moving
+// between the wallet and one counterparty. Swaps are left out since their
+// counterparty is a pool or program rather than someone funds went to.
+var flowKinds = map[string]bool{
+	taxreport.KindDeposit:    true,
+	taxreport.KindWithdrawal: true,
+	taxreport.KindTransfer:   true,
+	taxreport.KindAirdrop:    true,
+	taxreport.KindIncome:     true,
+	taxreport.KindGift:       true,
+}
+
+// flowBuilder adds classified transfers to a graph, each transfer once
+// however many of its sides are classified.
+type flowBuilder struct {
+	server *server
+	graph  *flowgraph.Graph
+	prices *priceHistory
+	seen   map[[4]string]bool
+}
+
+// GetFlowGraph graphs the transfers of the wallets over the range, one
+// edge per direction and asset between each wallet and the accounts funds
+// went to or came from, and optionally follows those accounts' own
+// transfers a hop or two further. Swaps are not transfers and are left
+// out.
+func (s *server) GetFlowGraph(ctx context.Context, req *pb.FlowGraphRequest) (*pb.FlowGraph, error) {
+	wallets, err := s.requestedWallets(ctx, req.WalletAddresses, req.PortfolioId, false)
+	if err != nil {
+		return nil, err
+	}
+	_, window, err := historyWindow(req.HistoryRange)
+	if err != nil {
+		return nil, err
+	}
+	if req.Depth < 0 || req.Depth > maxFlowDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "depth must be between 0 and %d", maxFlowDepth)
+	}
+	if req.MinValueUsd < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "min value must not be negative")
+	}
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if format != "" && format != "json" && format != "dot" {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown export format %q", req.Format)
+	}
+	treatments, err := s.income.Treatments(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	end := time.Now()
+	start := end.Add(-window)
+
+	flows := &flowBuilder{
+		server: s,
+		graph:  flowgraph.New(),
+		prices: newPriceHistory(start.Unix(), end.Unix()),
+		seen:   make(map[[4]string]bool),
+	}
+	expanded := make(map[string]bool)
+	for _, wallet := range wallets {
+		flows.graph.AddNode(wallet, 0).Requested = true
+		expanded[wallet] = true
+	}
+	classified, _, err := s.classifyWalletHistory(ctx, wallets, treatments, start.Unix(), end.Unix())
+	if err != nil {
+		return nil, transactionsError(err)
+	}
+	flows.add(classified, 1)
+	for depth := 1; depth <= int(req.Depth); depth++ {
+		for _, address := range flowFrontier(flows.graph, depth, expanded) {
+			expanded[address] = true
+			transfers, err := recentTransfers(ctx, address, treatments, start.Unix())
+			if err != nil {
+				return nil, transactionsError(err)
+			}
+			flows.add(transfers, depth+1)
+		}
+	}
+
+	flows.graph.Prune(req.MinValueUsd)
+	nodes := flows.graph.Nodes()
+	edges := flows.graph.Edges(req.MinValueUsd)
+	s.labelFlowNodes(ctx, nodes)
+	response := toPbFlowGraph(nodes, edges)
+	switch format {
+	case "json":
+		response.Export, err = protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(response)
+		response.Filename = fmt.Sprintf("pulse-flows-%s.json", end.UTC().Format(reportDate))
+	case "dot":
+		var rendered bytes.Buffer
+		err = flowgraph.WriteDOT(&rendered, nodes, edges)
+		response.Export = rendered.Bytes()
+		response.Filename = fmt.Sprintf("pulse-flows-%s.dot", end.UTC().Format(reportDate))
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export graph: %v", err)
+	}
+	return response, nil
+}
+
+// add adds the transfers of classified transactions of flowKinds, each
+// asset between the wallet and the account it went to or came from, which
+// is added at depth if new.
+func (f *flowBuilder) add(classified []classifiedTransaction, depth int) {
+	for _, transaction := range classified {
+		if !flowKinds[transaction.kind] {
+			continue
+		}
+		for _, t := range transaction.sent {
+			if to := transaction.peers[t.mint]; to != "" {
+				f.flow(transaction, transaction.wallet, to, t, depth)
+			}
+		}
+		for _, t := range transaction.received {
+			if from := transaction.peers[t.mint]; from != "" {
+				f.flow(transaction, from, transaction.wallet, t, depth)
+			}
+		}
+	}
+}
+
+// flow adds a transfer, adding the wallet's peer at depth if new.
+func (f *flowBuilder) flow(transaction classifiedTransaction, from, to string, t transfer, depth int) {
+	key := [4]string{transaction.signature, from, to, t.mint}
+	if f.seen[key] {
+		return
+	}
+	f.seen[key] = true
+	for _, address := range []string{from, to} {
+		if address == transaction.wallet {
+			f.graph.AddNode(address, depth-1)
+		} else {
+			f.graph.AddNode(address, depth)
+		}
+	}
+	value := t.amount * f.prices.price(t.mint, transaction.blockTime)
+	f.graph.AddFlow(from, to, t.mint, f.server.assetSymbol(t.mint), t.amount, value)
+}
+
+// flowFrontier returns the addresses at depth not yet expanded, the ones
+// with the most value flowing through them first, at most
+// maxFlowExpansions of them.
+func flowFrontier(graph *flowgraph.Graph, depth int, expanded map[string]bool) []string {
+	through := make(map[string]float64)
+	for _, edge := range graph.Edges(0) {
+		through[edge.From] += edge.Value
+		through[edge.To] += edge.Value
+	}
+	var frontier []string
+	for _, node := range graph.Nodes() {
+		if node.Depth == depth && !expanded[node.Address] {
+			frontier = append(frontier, node.Address)
+		}
+	}
+	sort.SliceStable(frontier, func(i, j int) bool {
+		return through[frontier[i]] > through[frontier[j]]
+	})
+	if len(frontier) > maxFlowExpansions {
+		frontier = frontier[:maxFlowExpansions]
+	}
+	return frontier
+}
+
+// recentTransfers classifies an address's most recent successful
+// transactions since start. Counterparties whose signatures cannot be listed
+// are left unexpanded.
+func recentTransfers(ctx context.Context, address string, treatments income.Treatments, start int64) ([]classifiedTransaction, error) {
+	hashes, err := solana_requests.GetTransactionHashesUntil(address, "", flowExpandSignatures)
+	if err != nil {
+		log.Warn("failed to fetch counterparty transactions", "address", address, "error", err)
+		return nil, nil
+	}
+	var signatures []string
+	for _, hash := range hashes {
+		if hash.BlockTime >= start && hash.Err == nil {
+			signatures = append(signatures, hash.Signature)
+		}
+	}
+	owned := map[string]bool{address: true}
+	var classified []classifiedTransaction
+	err = fetchTransactions(ctx, signatures, func(tx *pb.Transaction) {
+		transaction := classifyTransaction(tx, address, owned)
+		applyTreatment(&transaction, treatments)
+		classified = append(classified, transaction)
+	})
+	return classified, err
+}
+
+// labelFlowNodes labels nodes from the address book and looks up their
+// primary domains.
+func (s *server) labelFlowNodes(ctx context.Context, nodes []flowgraph.Node) {
+	if len(nodes) == 0 {
+		return
+	}
+	addresses := make([]string, len(nodes))
+	for i, node := range nodes {
+		addresses[i] = node.Address
+	}
+	labels, err := s.addressBook.Lookup(ctx, addresses)
+	if err != nil {
+		log.Warn("failed to look up address labels", "error", err)
+	}
+	domains, err := solana_requests.ReverseLookup(addresses)
+	if err != nil {
+		log.Warn("failed to look up domains", "error", err)
+	}
+	for i := range nodes {
+		nodes[i].Domain = domains[nodes[i].Address]
+		if entry, ok := labels[nodes[i].Address]; ok {
+			nodes[i].Label = entry.Label
+			nodes[i].Category = entry.Category
+		}
+	}
+}
+
+func toPbFlowGraph(nodes []flowgraph.Node, edges []flowgraph.Edge) *pb.FlowGraph {
+	graph := &pb.FlowGraph{}
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, &pb.FlowNode{
+			Address:   node.Address,
+			Label:     node.Label,
+			Category:  node.Category,
+			Domain:    node.Domain,
+			Requested: node.Requested,
+			Depth:     int32(node.Depth),
+		})
+	}
+	for _, edge := range edges {
+		graph.Edges = append(graph.Edges, &pb.FlowEdge{
+			From:   edge.From,
+			To:     edge.To,
+			Mint:   edge.Mint,
+			Symbol: edge.Symbol,
+			Amount: edge.Amount,
+			Value:  edge.Value,
+			Count:  int32(edge.Count),
+		})
+	}
+	return graph
+}
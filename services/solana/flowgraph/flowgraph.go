@@ -0,0 +1,190 @@
+// Package flowgraph builds a graph of the funds that flowed between
+// addresses, one weighted edge per direction and asset, and writes it as
+// GraphViz DOT.
+package flowgraph
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Node is an address in the graph. Depth is 0 for the requested wallets
+// and the number of hops away for the addresses found from them.
+type Node struct {
+	Address   string
+	Label     string
+	Category  string
+	Domain    string
+	Requested bool
+	Depth     int
+}
+
+// Name is how the node is shown: its label, else its domain, else its
+// shortened address.
+func (n Node) Name() string {
+	switch {
+	case n.Label != "":
+		return n.Label
+	case n.Domain != "":
+		return n.Domain
+	case len(n.Address) > 12:
+		return n.Address[:4] + "…" + n.Address[len(n.Address)-4:]
+	}
+	return n.Address
+}
+
+// Edge totals the transfers of one asset from one address to another.
+type Edge struct {
+	From   string
+	To     string
+	Mint   string
+	Symbol string
+	Amount float64
+	Value  float64
+	Count  int
+}
+
+type edgeKey struct {
+	from, to, mint string
+}
+
+// Graph collects nodes and edges. The zero value is not usable; use New.
+type Graph struct {
+	nodes map[string]*Node
+	edges map[edgeKey]*Edge
+}
+
+// New returns an empty graph.
+func New() *Graph {
+	return &Graph{nodes: make(map[string]*Node), edges: make(map[edgeKey]*Edge)}
+}
+
+// AddNode adds an address at a depth, or returns it if already present,
+// keeping the smaller depth.
+func (g *Graph) AddNode(address string, depth int) *Node {
+	node, ok := g.nodes[address]
+	if !ok {
+		node = &Node{Address: address, Depth: depth}
+		g.nodes[address] = node
+	}
+	node.Depth = min(node.Depth, depth)
+	return node
+}
+
+// Node returns the node of an address, or nil.
+func (g *Graph) Node(address string) *Node {
+	return g.nodes[address]
+}
+
+// AddFlow records a transfer of amount of an asset worth value from one
+// address to another. Both addresses must already be nodes.
+func (g *Graph) AddFlow(from, to, mint, symbol string, amount, value float64) {
+	key := edgeKey{from, to, mint}
+	edge, ok := g.edges[key]
+	if !ok {
+		edge = &Edge{From: from, To: to, Mint: mint, Symbol: symbol}
+		g.edges[key] = edge
+	}
+	edge.Amount += amount
+	edge.Value += value
+	edge.Count++
+}
+
+// Nodes returns the nodes, requested wallets first and then by depth and
+// address.
+func (g *Graph) Nodes() []Node {
+	nodes := make([]Node, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		nodes = append(nodes, *node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Requested != nodes[j].Requested {
+			return nodes[i].Requested
+		}
+		if nodes[i].Depth != nodes[j].Depth {
+			return nodes[i].Depth < nodes[j].Depth
+		}
+		return nodes[i].Address < nodes[j].Address
+	})
+	return nodes
+}
+
+// Edges returns the edges worth at least minValue, most valuable first.
+func (g *Graph) Edges(minValue float64) []Edge {
+	var edges []Edge
+	for _, edge := range g.edges {
+		if edge.Value >= minValue {
+			edges = append(edges, *edge)
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Value != edges[j].Value {
+			return edges[i].Value > edges[j].Value
+		}
+		if edges[i].From != edges[j].From {
+			return edges[i].From < edges[j].From
+		}
+		if edges[i].To != edges[j].To {
+			return edges[i].To < edges[j].To
+		}
+		return edges[i].Mint < edges[j].Mint
+	})
+	return edges
+}
+
+// Prune removes the nodes no edge worth at least minValue touches, other
+// than requested wallets.
+func (g *Graph) Prune(minValue float64) {
+	connected := make(map[string]bool)
+	for _, edge := range g.Edges(minValue) {
+		connected[edge.From] = true
+		connected[edge.To] = true
+	}
+	for address, node := range g.nodes {
+		if !node.Requested && !connected[address] {
+			delete(g.nodes, address)
+		}
+	}
+	for key, edge := range g.edges {
+		if edge.Value < minValue {
+			delete(g.edges, key)
+		}
+	}
+}
+
+// WriteDOT writes nodes and edges as a GraphViz digraph. Requested wallets
+// are drawn as boxes; edges are labelled with their amount, asset, value
+// and transfer count.
+func WriteDOT(w io.Writer, nodes []Node, edges []Edge) error {
+	var b strings.Builder
+	b.WriteString("digraph flows {\n")
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=ellipse];\n")
+	for _, node := range nodes {
+		attributes := []string{"label=" + quote(node.Name())}
+		if node.Requested {
+			attributes = append(attributes, "shape=box", "style=bold")
+		}
+		if node.Category != "" {
+			attributes = append(attributes, "tooltip="+quote(node.Category))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", quote(node.Address), strings.Join(attributes, ", "))
+	}
+	for _, edge := range edges {
+		label := fmt.Sprintf("%s %s\n$%.2f (%d)", strconv.FormatFloat(edge.Amount, 'f', -1, 64), edge.Symbol, edge.Value, edge.Count)
+		fmt.Fprintf(&b, "  %s -> %s [label=%s];\n", quote(edge.From), quote(edge.To), quote(label))
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// quote quotes a DOT identifier.
+func quote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return `"` + strings.ReplaceAll(s, "\n", `\n`) + `"`
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "solana/generated"
+	solana_requests "solana/requests/solana"
+	"solana/taxreport"
+)
+
+// slotSeconds is the target slot time, used to estimate which epochs fall
+// in a period.
+const slotSeconds = 0.4
+
+// GetCapitalGains replays the owned wallets' whole fetched history into
+// lots so disposals in the tax year are matched against earlier
+// acquisitions, and adds the staking rewards, airdrops and other income
+// received in it.
+func (s *server) GetCapitalGains(ctx context.Context, req *pb.CapitalGainsRequest) (*pb.CapitalGainsReport, error) {
+	wallets, err := s.requestedWallets(ctx, req.WalletAddresses, req.PortfolioId, true)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := parseTaxYear(req.TaxYear, req.YearStart, time.Now().UTC())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tax year: %v", err)
+	}
+	if req.LongTermDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "long term days must not be negative")
+	}
+	longTerm := taxreport.DefaultLongTerm
+	if req.LongTermDays > 0 {
+		longTerm = time.Duration(req.LongTermDays) * 24 * time.Hour
+	}
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if format != "" && format != "csv" && format != "text" {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown report format %q", req.Format)
+	}
+
+	treatments, err := s.income.Treatments(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	classified, truncated, err := s.classifyWalletHistory(ctx, wallets, treatments, 0, end.Unix())
+	if err != nil {
+		return nil, transactionsError(err)
+	}
+	first := start.Unix()
+	if len(classified) > 0 && classified[0].blockTime < first {
+		first = classified[0].blockTime
+	}
+	prices := newPriceHistory(first, end.Unix())
+	var entries []taxreport.Entry
+	for _, transaction := range classified {
+		entries = append(entries, s.taxEntry(transaction, prices))
+	}
+	income := taxreport.EntryIncome(entries)
+	income = append(income, stakingIncome(wallets, start, end, prices)...)
+	summary := taxreport.Gains(entries, income, start, end, longTerm)
+
+	report := toPbCapitalGains(summary)
+	report.Truncated = truncated
+	var rendered bytes.Buffer
+	switch format {
+	case "csv":
+		err = taxreport.WriteGainsCSV(&rendered, summary)
+		report.Filename = fmt.Sprintf("pulse-gains-%d.csv", summary.TaxYear)
+	case "text":
+		err = taxreport.WriteGainsText(&rendered, summary)
+		report.Filename = fmt.Sprintf("pulse-gains-%d.txt", summary.TaxYear)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write report: %v", err)
+	}
+	report.Report = rendered.Bytes()
+	return report, nil
+}
+
+// parseTaxYear returns the start of a tax year and of the next one. The
+// year is named by the calendar year it starts in.
+func parseTaxYear(year int32, yearStart string, now time.Time) (time.Time, time.Time, error) {
+	if year == 0 {
+		year = int32(now.Year())
+	}
+	if yearStart == "" {
+		yearStart = "01-01"
+	}
+	day, err := time.Parse("01-02", yearStart)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("year start %q is not MM-DD", yearStart)
+	}
+	start := time.Date(int(year), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(1, 0, 0), nil
+}
+
+// stakingIncome returns the inflation rewards the wallets' stake accounts
+// were paid in [start, end), valued at SOL's price when paid. The epochs
+// are estimated from slot times and each is then dated by its reward slot.
+// Rewards stay in the stake account, so they are income here but only
+// become a lot once withdrawn to a wallet.
+func stakingIncome(wallets []string, start, end time.Time, prices *priceHistory) []taxreport.Income {
+	var addresses []string
+	for _, wallet := range wallets {
+		accounts, err := solana_requests.GetStakeAccounts(wallet)
+		if err != nil {
+			log.Warn("failed to fetch stake accounts", "wallet", wallet, "error", err)
+			continue
+		}
+		for _, account := range accounts {
+			addresses = append(addresses, account.Pubkey)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	info, err := solana_requests.GetEpochInfo()
+	if err != nil {
+		log.Warn("failed to fetch epoch info for staking income", "error", err)
+		return nil
+	}
+	now := time.Now()
+	epochSeconds := float64(info.SlotsInEpoch) * slotSeconds
+	epochAt := func(t time.Time) int64 {
+		return int64(info.Epoch) - int64(now.Sub(t).Seconds()/epochSeconds)
+	}
+	// Pad the estimate by an epoch each way; exact times filter it below.
+	first := max(epochAt(start)-1, 0)
+	last := min(epochAt(end)+1, int64(info.Epoch)-1)
+
+	var income []taxreport.Income
+	for epoch := first; epoch <= last; epoch++ {
+		rewards, err := solana_requests.GetInflationRewards(addresses, uint64(epoch))
+		if err != nil {
+			log.Warn("failed to fetch inflation rewards", "epoch", epoch, "error", err)
+			continue
+		}
+		var paidAt time.Time
+		for i, reward := range rewards {
+			if reward == nil || i >= len(addresses) {
+				continue
+			}
+			if paidAt.IsZero() {
+				blockTime, err := solana_requests.GetBlockTime(reward.EffectiveSlot)
+				if err != nil {
+					log.Warn("failed to date inflation rewards", "epoch", epoch, "error", err)
+					break
+				}
+				paidAt = time.Unix(blockTime, 0).UTC()
+			}
+			if paidAt.Before(start) || !paidAt.Before(end) {
+				break
+			}
+			amount := float64(reward.Amount) / lamportsPerSol
+			income = append(income, taxreport.Income{
+				Time:   paidAt,
+				Kind:   taxreport.IncomeStakingReward,
+				Asset:  taxreport.Asset{Symbol: "SOL", Amount: amount, Value: amount * prices.price("", paidAt.Unix())},
+				Source: addresses[i],
+			})
+		}
+	}
+	return income
+}
+
+func toPbCapitalGains(summary taxreport.Summary) *pb.CapitalGainsReport {
+	report := &pb.CapitalGainsReport{
+		TaxYear:       int32(summary.TaxYear),
+		StartDate:     summary.Start.Format(reportDate),
+		EndDate:       summary.End.AddDate(0, 0, -1).Format(reportDate),
+		Proceeds:      summary.Proceeds,
+		Basis:         summary.Basis,
+		ShortTermGain: summary.ShortTermGain,
+		LongTermGain:  summary.LongTermGain,
+		TotalIncome:   summary.TotalIncome,
+		Fees:          summary.Fees,
+	}
+	for _, d := range summary.Disposals {
+		disposal := &pb.Disposal{
+			Symbol:       d.Symbol,
+			Mint:         d.Mint,
+			Amount:       d.Amount,
+			DisposedAt:   d.Disposed.UTC().Format(time.RFC3339),
+			HoldingDays:  int32(d.HoldingDays()),
+			Proceeds:     d.Proceeds,
+			Basis:        d.Basis,
+			Gain:         d.Gain,
+			LongTerm:     d.LongTerm,
+			BasisUnknown: d.BasisUnknown,
+			Signature:    d.TxHash,
+		}
+		if !d.BasisUnknown {
+			disposal.AcquiredAt = d.Acquired.UTC().Format(time.RFC3339)
+		}
+		report.Disposals = append(report.Disposals, disposal)
+	}
+	for _, item := range summary.Income {
+		report.Income = append(report.Income, &pb.IncomeItem{
+			Kind:       item.Kind,
+			Symbol:     item.Asset.Symbol,
+			Mint:       item.Asset.Mint,
+			Amount:     item.Asset.Amount,
+			Value:      item.Asset.Value,
+			ReceivedAt: item.Time.UTC().Format(time.RFC3339),
+			Source:     item.Source,
+			Signature:  item.TxHash,
+		})
+	}
+	return report
+}
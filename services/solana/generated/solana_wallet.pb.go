@@ -23,8 +23,25 @@ const (
 
 // Request message for a single wallet.
 type WalletRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A base58 address or a .sol domain.
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// How far back price history reaches, e.g. "24h", "7d" or "30d".
+	// Empty returns a single page of candles.
+	HistoryRange string `protobuf:"bytes,2,opt,name=history_range,json=historyRange,proto3" json:"history_range,omitempty"`
+	// Candle size: "1m", "5m", "15m", "1h", "4h", "12h" or "1d". Defaults to "1m".
+	HistoryResolution string `protobuf:"bytes,3,opt,name=history_resolution,json=historyResolution,proto3" json:"history_resolution,omitempty"`
+	// Move spam and dust tokens to WalletResponse.spam_tokens and skip their
+	// pool, price and metadata lookups.
+	HideSpam bool `protobuf:"varint,4,opt,name=hide_spam,json=hideSpam,proto3" json:"hide_spam,omitempty"`
+	// Holdings worth less than this many USD count as dust. 0 disables the check.
+	MinValueUsd float64 `protobuf:"fixed64,5,opt,name=min_value_usd,json=minValueUsd,proto3" json:"min_value_usd,omitempty"`
+	// How many past epochs of staking rewards to fetch. Defaults to 5.
+	RewardEpochs int32 `protobuf:"varint,6,opt,name=reward_epochs,json=rewardEpochs,proto3" json:"reward_epochs,omitempty"`
+	// Skip fetching transactions the signature list already shows failed.
+	// They are still listed in WalletResponse.failed_transactions, without
+	// their fee or decoded logs.
+	SkipFailed    bool `protobuf:"varint,7,opt,name=skip_failed,json=skipFailed,proto3" json:"skip_failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,28 +83,70 @@ func (x *WalletRequest) GetWalletAddress() string {
 	return ""
 }
 
-// Request message for multiple wallets.
-type MultiWalletRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	WalletAddresses []string               `protobuf:"bytes,1,rep,name=wallet_addresses,json=walletAddresses,proto3" json:"wallet_addresses,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *WalletRequest) GetHistoryRange() string {
+	if x != nil {
+		return x.HistoryRange
+	}
+	return ""
 }
 
-func (x *MultiWalletRequest) Reset() {
-	*x = MultiWalletRequest{}
+func (x *WalletRequest) GetHistoryResolution() string {
+	if x != nil {
+		return x.HistoryResolution
+	}
+	return ""
+}
+
+func (x *WalletRequest) GetHideSpam() bool {
+	if x != nil {
+		return x.HideSpam
+	}
+	return false
+}
+
+func (x *WalletRequest) GetMinValueUsd() float64 {
+	if x != nil {
+		return x.MinValueUsd
+	}
+	return 0
+}
+
+func (x *WalletRequest) GetRewardEpochs() int32 {
+	if x != nil {
+		return x.RewardEpochs
+	}
+	return 0
+}
+
+func (x *WalletRequest) GetSkipFailed() bool {
+	if x != nil {
+		return x.SkipFailed
+	}
+	return false
+}
+
+// Request message for a token risk report.
+type TokenRiskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mint          string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenRiskRequest) Reset() {
+	*x = TokenRiskRequest{}
 	mi := &file_proto_solana_wallet_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultiWalletRequest) String() string {
+func (x *TokenRiskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiWalletRequest) ProtoMessage() {}
+func (*TokenRiskRequest) ProtoMessage() {}
 
-func (x *MultiWalletRequest) ProtoReflect() protoreflect.Message {
+func (x *TokenRiskRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_solana_wallet_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -99,49 +158,56 @@ func (x *MultiWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiWalletRequest.ProtoReflect.Descriptor instead.
-func (*MultiWalletRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenRiskRequest.ProtoReflect.Descriptor instead.
+func (*TokenRiskRequest) Descriptor() ([]byte, []int) {
 	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *MultiWalletRequest) GetWalletAddresses() []string {
+func (x *TokenRiskRequest) GetMint() string {
 	if x != nil {
-		return x.WalletAddresses
+		return x.Mint
 	}
-	return nil
+	return ""
 }
 
-// Top‐level response message.
-type WalletResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Address           string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	SolBalance        float64                `protobuf:"fixed64,2,opt,name=sol_balance,json=solBalance,proto3" json:"sol_balance,omitempty"`
-	SolValue          float64                `protobuf:"fixed64,3,opt,name=sol_value,json=solValue,proto3" json:"sol_value,omitempty"`
-	WalletValue       float64                `protobuf:"fixed64,4,opt,name=wallet_value,json=walletValue,proto3" json:"wallet_value,omitempty"`
-	Tokens            []*Token               `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	Transactions      []*Transaction         `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	LastUpdated       string                 `protobuf:"bytes,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	TokenAmount       int32                  `protobuf:"varint,8,opt,name=token_amount,json=tokenAmount,proto3" json:"token_amount,omitempty"`
-	TransactionAmount int32                  `protobuf:"varint,9,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount,omitempty"`
-	Progress          float64                `protobuf:"fixed64,10,opt,name=progress,proto3" json:"progress,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Request message for multiple wallets.
+type MultiWalletRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base58 addresses or .sol domains.
+	WalletAddresses []string `protobuf:"bytes,1,rep,name=wallet_addresses,json=walletAddresses,proto3" json:"wallet_addresses,omitempty"`
+	// Same as WalletRequest.history_range.
+	HistoryRange string `protobuf:"bytes,2,opt,name=history_range,json=historyRange,proto3" json:"history_range,omitempty"`
+	// Same as WalletRequest.history_resolution.
+	HistoryResolution string `protobuf:"bytes,3,opt,name=history_resolution,json=historyResolution,proto3" json:"history_resolution,omitempty"`
+	// Same as WalletRequest.hide_spam.
+	HideSpam bool `protobuf:"varint,4,opt,name=hide_spam,json=hideSpam,proto3" json:"hide_spam,omitempty"`
+	// Same as WalletRequest.min_value_usd.
+	MinValueUsd float64 `protobuf:"fixed64,5,opt,name=min_value_usd,json=minValueUsd,proto3" json:"min_value_usd,omitempty"`
+	// Same as WalletRequest.reward_epochs.
+	RewardEpochs int32 `protobuf:"varint,6,opt,name=reward_epochs,json=rewardEpochs,proto3" json:"reward_epochs,omitempty"`
+	// Saved portfolio whose wallets are aggregated along with wallet_addresses.
+	// Only its owned wallets count for internal transfer detection.
+	PortfolioId int64 `protobuf:"varint,7,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// Same as WalletRequest.skip_failed.
+	SkipFailed    bool `protobuf:"varint,8,opt,name=skip_failed,json=skipFailed,proto3" json:"skip_failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WalletResponse) Reset() {
-	*x = WalletResponse{}
+func (x *MultiWalletRequest) Reset() {
+	*x = MultiWalletRequest{}
 	mi := &file_proto_solana_wallet_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WalletResponse) String() string {
+func (x *MultiWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletResponse) ProtoMessage() {}
+func (*MultiWalletRequest) ProtoMessage() {}
 
-func (x *WalletResponse) ProtoReflect() protoreflect.Message {
+func (x *MultiWalletRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_solana_wallet_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -153,113 +219,96 @@ func (x *WalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletResponse.ProtoReflect.Descriptor instead.
-func (*WalletResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiWalletRequest.ProtoReflect.Descriptor instead.
+func (*MultiWalletRequest) Descriptor() ([]byte, []int) {
 	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *WalletResponse) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *WalletResponse) GetSolBalance() float64 {
-	if x != nil {
-		return x.SolBalance
-	}
-	return 0
-}
-
-func (x *WalletResponse) GetSolValue() float64 {
+func (x *MultiWalletRequest) GetWalletAddresses() []string {
 	if x != nil {
-		return x.SolValue
+		return x.WalletAddresses
 	}
-	return 0
+	return nil
 }
 
-func (x *WalletResponse) GetWalletValue() float64 {
+func (x *MultiWalletRequest) GetHistoryRange() string {
 	if x != nil {
-		return x.WalletValue
+		return x.HistoryRange
 	}
-	return 0
+	return ""
 }
 
-func (x *WalletResponse) GetTokens() []*Token {
+func (x *MultiWalletRequest) GetHistoryResolution() string {
 	if x != nil {
-		return x.Tokens
+		return x.HistoryResolution
 	}
-	return nil
+	return ""
 }
 
-func (x *WalletResponse) GetTransactions() []*Transaction {
+func (x *MultiWalletRequest) GetHideSpam() bool {
 	if x != nil {
-		return x.Transactions
+		return x.HideSpam
 	}
-	return nil
+	return false
 }
 
-func (x *WalletResponse) GetLastUpdated() string {
+func (x *MultiWalletRequest) GetMinValueUsd() float64 {
 	if x != nil {
-		return x.LastUpdated
+		return x.MinValueUsd
 	}
-	return ""
+	return 0
 }
 
-func (x *WalletResponse) GetTokenAmount() int32 {
+func (x *MultiWalletRequest) GetRewardEpochs() int32 {
 	if x != nil {
-		return x.TokenAmount
+		return x.RewardEpochs
 	}
 	return 0
 }
 
-func (x *WalletResponse) GetTransactionAmount() int32 {
+func (x *MultiWalletRequest) GetPortfolioId() int64 {
 	if x != nil {
-		return x.TransactionAmount
+		return x.PortfolioId
 	}
 	return 0
 }
 
-func (x *WalletResponse) GetProgress() float64 {
+func (x *MultiWalletRequest) GetSkipFailed() bool {
 	if x != nil {
-		return x.Progress
+		return x.SkipFailed
 	}
-	return 0
+	return false
 }
 
-// Token information.
-type Token struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Pool          string                 `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
-	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Price         float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
-	Pnl           float64                `protobuf:"fixed64,8,opt,name=pnl,proto3" json:"pnl,omitempty"`
-	Invested      float64                `protobuf:"fixed64,9,opt,name=invested,proto3" json:"invested,omitempty"`
-	Value         float64                `protobuf:"fixed64,10,opt,name=value,proto3" json:"value,omitempty"`
-	HistoryPrices []*PricePoint          `protobuf:"bytes,11,rep,name=history_prices,json=historyPrices,proto3" json:"history_prices,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Request message for GetPortfolioHistory.
+type PortfolioHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base58 addresses or .sol domains. Their holdings are summed.
+	WalletAddresses []string `protobuf:"bytes,1,rep,name=wallet_addresses,json=walletAddresses,proto3" json:"wallet_addresses,omitempty"`
+	// Saved portfolio whose wallets are included along with wallet_addresses.
+	PortfolioId int64 `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// How far back the series reaches, e.g. "7d" or "90d". Defaults to "30d".
+	HistoryRange string `protobuf:"bytes,3,opt,name=history_range,json=historyRange,proto3" json:"history_range,omitempty"`
+	// Point spacing: "1h", "4h", "12h" or "1d". Defaults to "1d".
+	HistoryResolution string `protobuf:"bytes,4,opt,name=history_resolution,json=historyResolution,proto3" json:"history_resolution,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Token) Reset() {
-	*x = Token{}
+func (x *PortfolioHistoryRequest) Reset() {
+	*x = PortfolioHistoryRequest{}
 	mi := &file_proto_solana_wallet_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Token) String() string {
+func (x *PortfolioHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Token) ProtoMessage() {}
+func (*PortfolioHistoryRequest) ProtoMessage() {}
 
-func (x *Token) ProtoReflect() protoreflect.Message {
+func (x *PortfolioHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_solana_wallet_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -271,115 +320,135 @@ func (x *Token) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Token.ProtoReflect.Descriptor instead.
-func (*Token) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortfolioHistoryRequest.ProtoReflect.Descriptor instead.
+func (*PortfolioHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Token) GetName() string {
+func (x *PortfolioHistoryRequest) GetWalletAddresses() []string {
 	if x != nil {
-		return x.Name
+		return x.WalletAddresses
 	}
-	return ""
+	return nil
 }
 
-func (x *Token) GetAddress() string {
+func (x *PortfolioHistoryRequest) GetPortfolioId() int64 {
 	if x != nil {
-		return x.Address
+		return x.PortfolioId
 	}
-	return ""
+	return 0
 }
 
-func (x *Token) GetPool() string {
+func (x *PortfolioHistoryRequest) GetHistoryRange() string {
 	if x != nil {
-		return x.Pool
+		return x.HistoryRange
 	}
 	return ""
 }
 
-func (x *Token) GetDescription() string {
+func (x *PortfolioHistoryRequest) GetHistoryResolution() string {
 	if x != nil {
-		return x.Description
+		return x.HistoryResolution
 	}
 	return ""
 }
 
-func (x *Token) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
+// A holding at one point of a value series. The total series only sets
+// timestamp and value.
+type ValuePoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unix seconds.
+	Timestamp     int32   `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Amount        float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Value         float64 `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Token) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+func (x *ValuePoint) Reset() {
+	*x = ValuePoint{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Token) GetPrice() float64 {
+func (x *ValuePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValuePoint) ProtoMessage() {}
+
+func (x *ValuePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[4]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Token) GetPnl() float64 {
+// Deprecated: Use ValuePoint.ProtoReflect.Descriptor instead.
+func (*ValuePoint) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ValuePoint) GetTimestamp() int32 {
 	if x != nil {
-		return x.Pnl
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *Token) GetInvested() float64 {
+func (x *ValuePoint) GetAmount() float64 {
 	if x != nil {
-		return x.Invested
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *Token) GetValue() float64 {
+func (x *ValuePoint) GetPrice() float64 {
 	if x != nil {
-		return x.Value
+		return x.Price
 	}
 	return 0
 }
 
-func (x *Token) GetHistoryPrices() []*PricePoint {
+func (x *ValuePoint) GetValue() float64 {
 	if x != nil {
-		return x.HistoryPrices
+		return x.Value
 	}
-	return nil
+	return 0
 }
 
-type PricePoint struct {
+// The value series of one token. SOL has an empty mint.
+type TokenValueHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     int32                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
-	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
-	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
-	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Mint          string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Points        []*ValuePoint          `protobuf:"bytes,3,rep,name=points,proto3" json:"points,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PricePoint) Reset() {
-	*x = PricePoint{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[4]
+func (x *TokenValueHistory) Reset() {
+	*x = TokenValueHistory{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PricePoint) String() string {
+func (x *TokenValueHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PricePoint) ProtoMessage() {}
+func (*TokenValueHistory) ProtoMessage() {}
 
-func (x *PricePoint) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[4]
+func (x *TokenValueHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,79 +459,119 @@ func (x *PricePoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PricePoint.ProtoReflect.Descriptor instead.
-func (*PricePoint) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use TokenValueHistory.ProtoReflect.Descriptor instead.
+func (*TokenValueHistory) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PricePoint) GetTimestamp() int32 {
+func (x *TokenValueHistory) GetMint() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Mint
 	}
-	return 0
+	return ""
 }
 
-func (x *PricePoint) GetOpen() float64 {
+func (x *TokenValueHistory) GetSymbol() string {
 	if x != nil {
-		return x.Open
+		return x.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (x *PricePoint) GetHigh() float64 {
+func (x *TokenValueHistory) GetPoints() []*ValuePoint {
 	if x != nil {
-		return x.High
+		return x.Points
 	}
-	return 0
+	return nil
 }
 
-func (x *PricePoint) GetLow() float64 {
-	if x != nil {
-		return x.Low
-	}
-	return 0
+type PortfolioHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first.
+	Total         []*ValuePoint        `protobuf:"bytes,1,rep,name=total,proto3" json:"total,omitempty"`
+	Tokens        []*TokenValueHistory `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PricePoint) GetClose() float64 {
-	if x != nil {
-		return x.Close
+func (x *PortfolioHistoryResponse) Reset() {
+	*x = PortfolioHistoryResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioHistoryResponse) ProtoMessage() {}
+
+func (x *PortfolioHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *PricePoint) GetVolume() float64 {
+// Deprecated: Use PortfolioHistoryResponse.ProtoReflect.Descriptor instead.
+func (*PortfolioHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PortfolioHistoryResponse) GetTotal() []*ValuePoint {
 	if x != nil {
-		return x.Volume
+		return x.Total
 	}
-	return 0
+	return nil
 }
 
-// A transaction container.
-type Transaction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Jsonrpc       string                 `protobuf:"bytes,1,opt,name=jsonrpc,proto3" json:"jsonrpc,omitempty"`
-	Result        *TransactionResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
-	Err           *Error                 `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
-	Id            int32                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+func (x *PortfolioHistoryResponse) GetTokens() []*TokenValueHistory {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// Request message for ExportTaxReport.
+type TaxReportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base58 addresses or .sol domains.
+	WalletAddresses []string `protobuf:"bytes,1,rep,name=wallet_addresses,json=walletAddresses,proto3" json:"wallet_addresses,omitempty"`
+	// Saved portfolio whose owned wallets are reported along with
+	// wallet_addresses.
+	PortfolioId int64 `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// "koinly" (Koinly universal format), "cointracker" or "ledger" (one row
+	// per asset movement).
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	// First and last day reported, "2006-01-02" in UTC. Default to the start
+	// of the current year and today.
+	StartDate     string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[5]
+func (x *TaxReportRequest) Reset() {
+	*x = TaxReportRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *TaxReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*TaxReportRequest) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[5]
+func (x *TaxReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -473,62 +582,76 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use TaxReportRequest.ProtoReflect.Descriptor instead.
+func (*TaxReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Transaction) GetJsonrpc() string {
+func (x *TaxReportRequest) GetWalletAddresses() []string {
 	if x != nil {
-		return x.Jsonrpc
+		return x.WalletAddresses
 	}
-	return ""
+	return nil
 }
 
-func (x *Transaction) GetResult() *TransactionResult {
+func (x *TaxReportRequest) GetPortfolioId() int64 {
 	if x != nil {
-		return x.Result
+		return x.PortfolioId
 	}
-	return nil
+	return 0
 }
 
-func (x *Transaction) GetErr() *Error {
+func (x *TaxReportRequest) GetFormat() string {
 	if x != nil {
-		return x.Err
+		return x.Format
 	}
-	return nil
+	return ""
 }
 
-func (x *Transaction) GetId() int32 {
+func (x *TaxReportRequest) GetStartDate() string {
 	if x != nil {
-		return x.Id
+		return x.StartDate
 	}
-	return 0
+	return ""
 }
 
-type Error struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (x *TaxReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type TaxReport struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// Suggested file name.
+	Filename string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	Csv      []byte `protobuf:"bytes,3,opt,name=csv,proto3" json:"csv,omitempty"`
+	// Rows written, excluding the header.
+	Rows int32 `protobuf:"varint,4,opt,name=rows,proto3" json:"rows,omitempty"`
+	// Set when a wallet has more history than can be fetched, so its oldest
+	// transactions in the period are missing from the report.
+	Truncated     bool `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Error) Reset() {
-	*x = Error{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[6]
+func (x *TaxReport) Reset() {
+	*x = TaxReport{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Error) String() string {
+func (x *TaxReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Error) ProtoMessage() {}
+func (*TaxReport) ProtoMessage() {}
 
-func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[6]
+func (x *TaxReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -539,51 +662,80 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Error.ProtoReflect.Descriptor instead.
-func (*Error) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use TaxReport.ProtoReflect.Descriptor instead.
+func (*TaxReport) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Error) GetCode() int32 {
+func (x *TaxReport) GetFormat() string {
 	if x != nil {
-		return x.Code
+		return x.Format
 	}
-	return 0
+	return ""
 }
 
-func (x *Error) GetMessage() string {
+func (x *TaxReport) GetFilename() string {
 	if x != nil {
-		return x.Message
+		return x.Filename
 	}
 	return ""
 }
 
-// The detailed transaction result.
-type TransactionResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BlockTime     int64                  `protobuf:"varint,1,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
-	Meta          *Meta                  `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
-	Slot          uint64                 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
-	Transaction   *TransactionData       `protobuf:"bytes,4,opt,name=transaction,proto3" json:"transaction,omitempty"`
+func (x *TaxReport) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *TaxReport) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *TaxReport) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// Request message for GetCapitalGains.
+type CapitalGainsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base58 addresses or .sol domains. Lots are pooled across them.
+	WalletAddresses []string `protobuf:"bytes,1,rep,name=wallet_addresses,json=walletAddresses,proto3" json:"wallet_addresses,omitempty"`
+	// Saved portfolio whose owned wallets are included.
+	PortfolioId int64 `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// Defaults to the current year.
+	TaxYear int32 `protobuf:"varint,3,opt,name=tax_year,json=taxYear,proto3" json:"tax_year,omitempty"`
+	// First day of the tax year as "MM-DD", e.g. "04-06". Defaults to "01-01".
+	YearStart string `protobuf:"bytes,4,opt,name=year_start,json=yearStart,proto3" json:"year_start,omitempty"`
+	// Disposals of lots held longer than this are long term. Defaults to 365.
+	LongTermDays int32 `protobuf:"varint,5,opt,name=long_term_days,json=longTermDays,proto3" json:"long_term_days,omitempty"`
+	// "csv" or "text" also renders the report into CapitalGainsReport.report.
+	Format        string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionResult) Reset() {
-	*x = TransactionResult{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[7]
+func (x *CapitalGainsRequest) Reset() {
+	*x = CapitalGainsRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionResult) String() string {
+func (x *CapitalGainsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionResult) ProtoMessage() {}
+func (*CapitalGainsRequest) ProtoMessage() {}
 
-func (x *TransactionResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[7]
+func (x *CapitalGainsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,71 +746,90 @@ func (x *TransactionResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionResult.ProtoReflect.Descriptor instead.
-func (*TransactionResult) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CapitalGainsRequest.ProtoReflect.Descriptor instead.
+func (*CapitalGainsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TransactionResult) GetBlockTime() int64 {
+func (x *CapitalGainsRequest) GetWalletAddresses() []string {
 	if x != nil {
-		return x.BlockTime
+		return x.WalletAddresses
+	}
+	return nil
+}
+
+func (x *CapitalGainsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
 	}
 	return 0
 }
 
-func (x *TransactionResult) GetMeta() *Meta {
+func (x *CapitalGainsRequest) GetTaxYear() int32 {
 	if x != nil {
-		return x.Meta
+		return x.TaxYear
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionResult) GetSlot() uint64 {
+func (x *CapitalGainsRequest) GetYearStart() string {
 	if x != nil {
-		return x.Slot
+		return x.YearStart
+	}
+	return ""
+}
+
+func (x *CapitalGainsRequest) GetLongTermDays() int32 {
+	if x != nil {
+		return x.LongTermDays
 	}
 	return 0
 }
 
-func (x *TransactionResult) GetTransaction() *TransactionData {
+func (x *CapitalGainsRequest) GetFormat() string {
 	if x != nil {
-		return x.Transaction
+		return x.Format
 	}
-	return nil
+	return ""
 }
 
-// Meta data for a transaction.
-type Meta struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	ComputeUnitsConsumed uint64                 `protobuf:"varint,1,opt,name=compute_units_consumed,json=computeUnitsConsumed,proto3" json:"compute_units_consumed,omitempty"`
-	Fee                  uint64                 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
-	InnerInstructions    []*InnerInstruction    `protobuf:"bytes,3,rep,name=inner_instructions,json=innerInstructions,proto3" json:"inner_instructions,omitempty"`
-	LogMessages          []string               `protobuf:"bytes,4,rep,name=log_messages,json=logMessages,proto3" json:"log_messages,omitempty"`
-	PostBalances         []uint64               `protobuf:"varint,5,rep,packed,name=post_balances,json=postBalances,proto3" json:"post_balances,omitempty"`
-	PreBalances          []uint64               `protobuf:"varint,6,rep,packed,name=pre_balances,json=preBalances,proto3" json:"pre_balances,omitempty"`
-	PostTokenBalances    []*TokenBalance        `protobuf:"bytes,7,rep,name=post_token_balances,json=postTokenBalances,proto3" json:"post_token_balances,omitempty"`
-	PreTokenBalances     []*TokenBalance        `protobuf:"bytes,8,rep,name=pre_token_balances,json=preTokenBalances,proto3" json:"pre_token_balances,omitempty"`
-	Rewards              []*Reward              `protobuf:"bytes,9,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Status               *Status                `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+// The disposal of all or part of one lot.
+type Disposal struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Symbol string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Mint   string                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Amount float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// RFC 3339 times. acquired_at is empty when the basis is unknown.
+	AcquiredAt  string  `protobuf:"bytes,4,opt,name=acquired_at,json=acquiredAt,proto3" json:"acquired_at,omitempty"`
+	DisposedAt  string  `protobuf:"bytes,5,opt,name=disposed_at,json=disposedAt,proto3" json:"disposed_at,omitempty"`
+	HoldingDays int32   `protobuf:"varint,6,opt,name=holding_days,json=holdingDays,proto3" json:"holding_days,omitempty"`
+	Proceeds    float64 `protobuf:"fixed64,7,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	Basis       float64 `protobuf:"fixed64,8,opt,name=basis,proto3" json:"basis,omitempty"`
+	Gain        float64 `protobuf:"fixed64,9,opt,name=gain,proto3" json:"gain,omitempty"`
+	LongTerm    bool    `protobuf:"varint,10,opt,name=long_term,json=longTerm,proto3" json:"long_term,omitempty"`
+	// Set for amounts disposed of beyond the acquisitions in the history;
+	// their basis is 0.
+	BasisUnknown  bool   `protobuf:"varint,11,opt,name=basis_unknown,json=basisUnknown,proto3" json:"basis_unknown,omitempty"`
+	Signature     string `protobuf:"bytes,12,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Meta) Reset() {
-	*x = Meta{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[8]
+func (x *Disposal) Reset() {
+	*x = Disposal{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Meta) String() string {
+func (x *Disposal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Meta) ProtoMessage() {}
+func (*Disposal) ProtoMessage() {}
 
-func (x *Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[8]
+func (x *Disposal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,105 +840,128 @@ func (x *Meta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
-func (*Meta) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use Disposal.ProtoReflect.Descriptor instead.
+func (*Disposal) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Meta) GetComputeUnitsConsumed() uint64 {
+func (x *Disposal) GetSymbol() string {
 	if x != nil {
-		return x.ComputeUnitsConsumed
+		return x.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (x *Meta) GetFee() uint64 {
+func (x *Disposal) GetMint() string {
 	if x != nil {
-		return x.Fee
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *Disposal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *Meta) GetInnerInstructions() []*InnerInstruction {
+func (x *Disposal) GetAcquiredAt() string {
 	if x != nil {
-		return x.InnerInstructions
+		return x.AcquiredAt
 	}
-	return nil
+	return ""
 }
 
-func (x *Meta) GetLogMessages() []string {
+func (x *Disposal) GetDisposedAt() string {
 	if x != nil {
-		return x.LogMessages
+		return x.DisposedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *Meta) GetPostBalances() []uint64 {
+func (x *Disposal) GetHoldingDays() int32 {
 	if x != nil {
-		return x.PostBalances
+		return x.HoldingDays
 	}
-	return nil
+	return 0
 }
 
-func (x *Meta) GetPreBalances() []uint64 {
+func (x *Disposal) GetProceeds() float64 {
 	if x != nil {
-		return x.PreBalances
+		return x.Proceeds
 	}
-	return nil
+	return 0
 }
 
-func (x *Meta) GetPostTokenBalances() []*TokenBalance {
+func (x *Disposal) GetBasis() float64 {
 	if x != nil {
-		return x.PostTokenBalances
+		return x.Basis
 	}
-	return nil
+	return 0
 }
 
-func (x *Meta) GetPreTokenBalances() []*TokenBalance {
+func (x *Disposal) GetGain() float64 {
 	if x != nil {
-		return x.PreTokenBalances
+		return x.Gain
 	}
-	return nil
+	return 0
 }
 
-func (x *Meta) GetRewards() []*Reward {
+func (x *Disposal) GetLongTerm() bool {
 	if x != nil {
-		return x.Rewards
+		return x.LongTerm
 	}
-	return nil
+	return false
 }
 
-func (x *Meta) GetStatus() *Status {
+func (x *Disposal) GetBasisUnknown() bool {
 	if x != nil {
-		return x.Status
+		return x.BasisUnknown
 	}
-	return nil
+	return false
 }
 
-// Inner instructions.
-type InnerInstruction struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	Instructions  []*Instruction         `protobuf:"bytes,2,rep,name=instructions,proto3" json:"instructions,omitempty"`
+func (x *Disposal) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+// An asset received as income, valued at receipt.
+type IncomeItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "staking_reward", "airdrop" or "income".
+	Kind   string  `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Symbol string  `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Mint   string  `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	Amount float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Value  float64 `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	// RFC 3339 time.
+	ReceivedAt string `protobuf:"bytes,6,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	// Stake account of a reward, or the wallet that received an airdrop.
+	Source        string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+	Signature     string `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InnerInstruction) Reset() {
-	*x = InnerInstruction{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[9]
+func (x *IncomeItem) Reset() {
+	*x = IncomeItem{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InnerInstruction) String() string {
+func (x *IncomeItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InnerInstruction) ProtoMessage() {}
+func (*IncomeItem) ProtoMessage() {}
 
-func (x *InnerInstruction) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[9]
+func (x *IncomeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -778,128 +972,108 @@ func (x *InnerInstruction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InnerInstruction.ProtoReflect.Descriptor instead.
-func (*InnerInstruction) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use IncomeItem.ProtoReflect.Descriptor instead.
+func (*IncomeItem) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *InnerInstruction) GetIndex() uint32 {
+func (x *IncomeItem) GetKind() string {
 	if x != nil {
-		return x.Index
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *InnerInstruction) GetInstructions() []*Instruction {
+func (x *IncomeItem) GetSymbol() string {
 	if x != nil {
-		return x.Instructions
+		return x.Symbol
 	}
-	return nil
-}
-
-// A token balance record.
-type TokenBalance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountIndex  uint32                 `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
-	Mint          string                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
-	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
-	ProgramId     string                 `protobuf:"bytes,4,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
-	UiTokenAmount *TokenAmount           `protobuf:"bytes,5,opt,name=ui_token_amount,json=uiTokenAmount,proto3" json:"ui_token_amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *TokenBalance) Reset() {
-	*x = TokenBalance{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TokenBalance) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*TokenBalance) ProtoMessage() {}
-
-func (x *TokenBalance) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[10]
+func (x *IncomeItem) GetMint() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Mint
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TokenBalance.ProtoReflect.Descriptor instead.
-func (*TokenBalance) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{10}
+	return ""
 }
 
-func (x *TokenBalance) GetAccountIndex() uint32 {
+func (x *IncomeItem) GetAmount() float64 {
 	if x != nil {
-		return x.AccountIndex
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *TokenBalance) GetMint() string {
+func (x *IncomeItem) GetValue() float64 {
 	if x != nil {
-		return x.Mint
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *TokenBalance) GetOwner() string {
+func (x *IncomeItem) GetReceivedAt() string {
 	if x != nil {
-		return x.Owner
+		return x.ReceivedAt
 	}
 	return ""
 }
 
-func (x *TokenBalance) GetProgramId() string {
+func (x *IncomeItem) GetSource() string {
 	if x != nil {
-		return x.ProgramId
+		return x.Source
 	}
 	return ""
 }
 
-func (x *TokenBalance) GetUiTokenAmount() *TokenAmount {
+func (x *IncomeItem) GetSignature() string {
 	if x != nil {
-		return x.UiTokenAmount
+		return x.Signature
 	}
-	return nil
+	return ""
 }
 
-// Token amount details.
-type TokenAmount struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Amount         string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Decimals       uint32                 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	UiAmount       float64                `protobuf:"fixed64,3,opt,name=ui_amount,json=uiAmount,proto3" json:"ui_amount,omitempty"`
-	UiAmountString string                 `protobuf:"bytes,4,opt,name=ui_amount_string,json=uiAmountString,proto3" json:"ui_amount_string,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CapitalGainsReport struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TaxYear int32                  `protobuf:"varint,1,opt,name=tax_year,json=taxYear,proto3" json:"tax_year,omitempty"`
+	// First and last day of the tax year.
+	StartDate     string        `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string        `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Disposals     []*Disposal   `protobuf:"bytes,4,rep,name=disposals,proto3" json:"disposals,omitempty"`
+	Income        []*IncomeItem `protobuf:"bytes,5,rep,name=income,proto3" json:"income,omitempty"`
+	Proceeds      float64       `protobuf:"fixed64,6,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	Basis         float64       `protobuf:"fixed64,7,opt,name=basis,proto3" json:"basis,omitempty"`
+	ShortTermGain float64       `protobuf:"fixed64,8,opt,name=short_term_gain,json=shortTermGain,proto3" json:"short_term_gain,omitempty"`
+	LongTermGain  float64       `protobuf:"fixed64,9,opt,name=long_term_gain,json=longTermGain,proto3" json:"long_term_gain,omitempty"`
+	TotalIncome   float64       `protobuf:"fixed64,10,opt,name=total_income,json=totalIncome,proto3" json:"total_income,omitempty"`
+	// The rendered report when a format was requested, and a file name for it.
+	Report   []byte `protobuf:"bytes,11,opt,name=report,proto3" json:"report,omitempty"`
+	Filename string `protobuf:"bytes,12,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Set when a wallet has more history than can be fetched, so its oldest
+	// lots are missing and some bases are unknown.
+	Truncated bool `protobuf:"varint,13,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Network fees paid in the year. Their SOL is taken from its lots but
+	// they are not reported as disposals.
+	Fees          float64 `protobuf:"fixed64,14,opt,name=fees,proto3" json:"fees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TokenAmount) Reset() {
-	*x = TokenAmount{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[11]
+func (x *CapitalGainsReport) Reset() {
+	*x = CapitalGainsReport{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TokenAmount) String() string {
+func (x *CapitalGainsReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenAmount) ProtoMessage() {}
+func (*CapitalGainsReport) ProtoMessage() {}
 
-func (x *TokenAmount) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[11]
+func (x *CapitalGainsReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,110 +1084,137 @@ func (x *TokenAmount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenAmount.ProtoReflect.Descriptor instead.
-func (*TokenAmount) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use CapitalGainsReport.ProtoReflect.Descriptor instead.
+func (*CapitalGainsReport) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *TokenAmount) GetAmount() string {
+func (x *CapitalGainsReport) GetTaxYear() int32 {
 	if x != nil {
-		return x.Amount
+		return x.TaxYear
+	}
+	return 0
+}
+
+func (x *CapitalGainsReport) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *TokenAmount) GetDecimals() uint32 {
+func (x *CapitalGainsReport) GetEndDate() string {
 	if x != nil {
-		return x.Decimals
+		return x.EndDate
 	}
-	return 0
+	return ""
 }
 
-func (x *TokenAmount) GetUiAmount() float64 {
+func (x *CapitalGainsReport) GetDisposals() []*Disposal {
 	if x != nil {
-		return x.UiAmount
+		return x.Disposals
 	}
-	return 0
+	return nil
 }
 
-func (x *TokenAmount) GetUiAmountString() string {
+func (x *CapitalGainsReport) GetIncome() []*IncomeItem {
 	if x != nil {
-		return x.UiAmountString
+		return x.Income
 	}
-	return ""
+	return nil
 }
 
-// Reward information.
-type Reward struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Info          string                 `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CapitalGainsReport) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
 }
 
-func (x *Reward) Reset() {
-	*x = Reward{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CapitalGainsReport) GetBasis() float64 {
+	if x != nil {
+		return x.Basis
+	}
+	return 0
 }
 
-func (x *Reward) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CapitalGainsReport) GetShortTermGain() float64 {
+	if x != nil {
+		return x.ShortTermGain
+	}
+	return 0
 }
 
-func (*Reward) ProtoMessage() {}
+func (x *CapitalGainsReport) GetLongTermGain() float64 {
+	if x != nil {
+		return x.LongTermGain
+	}
+	return 0
+}
 
-func (x *Reward) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[12]
+func (x *CapitalGainsReport) GetTotalIncome() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TotalIncome
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
-func (*Reward) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{12}
+func (x *CapitalGainsReport) GetReport() []byte {
+	if x != nil {
+		return x.Report
+	}
+	return nil
 }
 
-func (x *Reward) GetInfo() string {
+func (x *CapitalGainsReport) GetFilename() string {
 	if x != nil {
-		return x.Info
+		return x.Filename
 	}
 	return ""
 }
 
-// Status structure.
-type Status struct {
+func (x *CapitalGainsReport) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *CapitalGainsReport) GetFees() float64 {
+	if x != nil {
+		return x.Fees
+	}
+	return 0
+}
+
+type FeeReportRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Result:
-	//
-	//	*Status_Ok
-	//	*Status_ErrorMessage
-	Result        isStatus_Result `protobuf_oneof:"result"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Base58 addresses or .sol domains.
+	WalletAddresses []string `protobuf:"bytes,1,rep,name=wallet_addresses,json=walletAddresses,proto3" json:"wallet_addresses,omitempty"`
+	// Adds every wallet of a saved portfolio.
+	PortfolioId int64 `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// How far back to look, e.g. "7d" or "90d". Defaults to "30d".
+	HistoryRange string `protobuf:"bytes,3,opt,name=history_range,json=historyRange,proto3" json:"history_range,omitempty"`
+	// Bucket size: "1h", "4h", "12h" or "1d". Defaults to "1d".
+	HistoryResolution string `protobuf:"bytes,4,opt,name=history_resolution,json=historyResolution,proto3" json:"history_resolution,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Status) Reset() {
-	*x = Status{}
+func (x *FeeReportRequest) Reset() {
+	*x = FeeReportRequest{}
 	mi := &file_proto_solana_wallet_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Status) String() string {
+func (x *FeeReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*FeeReportRequest) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
+func (x *FeeReportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_solana_wallet_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,75 +1226,62 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeeReportRequest.ProtoReflect.Descriptor instead.
+func (*FeeReportRequest) Descriptor() ([]byte, []int) {
 	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *Status) GetResult() isStatus_Result {
+func (x *FeeReportRequest) GetWalletAddresses() []string {
 	if x != nil {
-		return x.Result
+		return x.WalletAddresses
 	}
 	return nil
 }
 
-func (x *Status) GetOk() string {
+func (x *FeeReportRequest) GetPortfolioId() int64 {
 	if x != nil {
-		if x, ok := x.Result.(*Status_Ok); ok {
-			return x.Ok
-		}
+		return x.PortfolioId
 	}
-	return ""
+	return 0
 }
 
-func (x *Status) GetErrorMessage() string {
+func (x *FeeReportRequest) GetHistoryRange() string {
 	if x != nil {
-		if x, ok := x.Result.(*Status_ErrorMessage); ok {
-			return x.ErrorMessage
-		}
+		return x.HistoryRange
 	}
 	return ""
 }
 
-type isStatus_Result interface {
-	isStatus_Result()
-}
-
-type Status_Ok struct {
-	Ok string `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
-}
-
-type Status_ErrorMessage struct {
-	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof"`
+func (x *FeeReportRequest) GetHistoryResolution() string {
+	if x != nil {
+		return x.HistoryResolution
+	}
+	return ""
 }
 
-func (*Status_Ok) isStatus_Result() {}
-
-func (*Status_ErrorMessage) isStatus_Result() {}
-
-// The actual transaction data.
-type TransactionData struct {
+// An amount of SOL and its USD value when it was paid.
+type FeeAmount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       *TransactionMessage    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Signatures    []string               `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Sol           float64                `protobuf:"fixed64,1,opt,name=sol,proto3" json:"sol,omitempty"`
+	Usd           float64                `protobuf:"fixed64,2,opt,name=usd,proto3" json:"usd,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionData) Reset() {
-	*x = TransactionData{}
+func (x *FeeAmount) Reset() {
+	*x = FeeAmount{}
 	mi := &file_proto_solana_wallet_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionData) String() string {
+func (x *FeeAmount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionData) ProtoMessage() {}
+func (*FeeAmount) ProtoMessage() {}
 
-func (x *TransactionData) ProtoReflect() protoreflect.Message {
+func (x *FeeAmount) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_solana_wallet_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1105,51 +1293,56 @@ func (x *TransactionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
-func (*TransactionData) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeeAmount.ProtoReflect.Descriptor instead.
+func (*FeeAmount) Descriptor() ([]byte, []int) {
 	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TransactionData) GetMessage() *TransactionMessage {
+func (x *FeeAmount) GetSol() float64 {
 	if x != nil {
-		return x.Message
+		return x.Sol
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionData) GetSignatures() []string {
+func (x *FeeAmount) GetUsd() float64 {
 	if x != nil {
-		return x.Signatures
+		return x.Usd
 	}
-	return nil
+	return 0
 }
 
-// The transaction message details.
-type TransactionMessage struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	AccountKeys         []string               `protobuf:"bytes,1,rep,name=account_keys,json=accountKeys,proto3" json:"account_keys,omitempty"`
-	AddressTableLookups []*AddressTableLookup  `protobuf:"bytes,2,rep,name=address_table_lookups,json=addressTableLookups,proto3" json:"address_table_lookups,omitempty"`
-	Header              *MessageHeader         `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
-	Instructions        []*Instruction         `protobuf:"bytes,4,rep,name=instructions,proto3" json:"instructions,omitempty"`
-	RecentBlockhash     string                 `protobuf:"bytes,5,opt,name=recent_blockhash,json=recentBlockhash,proto3" json:"recent_blockhash,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// Compute units consumed per transaction.
+type ComputeUsage struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Transactions int32                  `protobuf:"varint,1,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	Total        uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Mean         float64                `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+	Min          uint64                 `protobuf:"varint,4,opt,name=min,proto3" json:"min,omitempty"`
+	Median       uint64                 `protobuf:"varint,5,opt,name=median,proto3" json:"median,omitempty"`
+	P90          uint64                 `protobuf:"varint,6,opt,name=p90,proto3" json:"p90,omitempty"`
+	Max          uint64                 `protobuf:"varint,7,opt,name=max,proto3" json:"max,omitempty"`
+	// Mean compute unit price, in micro-lamports, of the transactions that
+	// set one.
+	MeanUnitPrice float64 `protobuf:"fixed64,8,opt,name=mean_unit_price,json=meanUnitPrice,proto3" json:"mean_unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionMessage) Reset() {
-	*x = TransactionMessage{}
+func (x *ComputeUsage) Reset() {
+	*x = ComputeUsage{}
 	mi := &file_proto_solana_wallet_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionMessage) String() string {
+func (x *ComputeUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionMessage) ProtoMessage() {}
+func (*ComputeUsage) ProtoMessage() {}
 
-func (x *TransactionMessage) ProtoReflect() protoreflect.Message {
+func (x *ComputeUsage) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_solana_wallet_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1161,70 +1354,98 @@ func (x *TransactionMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionMessage.ProtoReflect.Descriptor instead.
-func (*TransactionMessage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ComputeUsage.ProtoReflect.Descriptor instead.
+func (*ComputeUsage) Descriptor() ([]byte, []int) {
 	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TransactionMessage) GetAccountKeys() []string {
+func (x *ComputeUsage) GetTransactions() int32 {
 	if x != nil {
-		return x.AccountKeys
+		return x.Transactions
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionMessage) GetAddressTableLookups() []*AddressTableLookup {
+func (x *ComputeUsage) GetTotal() uint64 {
 	if x != nil {
-		return x.AddressTableLookups
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionMessage) GetHeader() *MessageHeader {
+func (x *ComputeUsage) GetMean() float64 {
 	if x != nil {
-		return x.Header
+		return x.Mean
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionMessage) GetInstructions() []*Instruction {
+func (x *ComputeUsage) GetMin() uint64 {
 	if x != nil {
-		return x.Instructions
+		return x.Min
 	}
-	return nil
+	return 0
 }
 
-func (x *TransactionMessage) GetRecentBlockhash() string {
+func (x *ComputeUsage) GetMedian() uint64 {
 	if x != nil {
-		return x.RecentBlockhash
+		return x.Median
 	}
-	return ""
+	return 0
 }
 
-// Address table lookups.
-type AddressTableLookup struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	AccountKey      string                 `protobuf:"bytes,1,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
-	ReadonlyIndexes []uint32               `protobuf:"varint,2,rep,packed,name=readonly_indexes,json=readonlyIndexes,proto3" json:"readonly_indexes,omitempty"`
-	WritableIndexes []uint32               `protobuf:"varint,3,rep,packed,name=writable_indexes,json=writableIndexes,proto3" json:"writable_indexes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *ComputeUsage) GetP90() uint64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
 }
 
-func (x *AddressTableLookup) Reset() {
-	*x = AddressTableLookup{}
+func (x *ComputeUsage) GetMax() uint64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ComputeUsage) GetMeanUnitPrice() float64 {
+	if x != nil {
+		return x.MeanUnitPrice
+	}
+	return 0
+}
+
+// Fees paid for a set of transactions. base, priority and tips add up to
+// total; failed_cost is the part of total paid for failed transactions.
+type FeeTotals struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Transactions       int32                  `protobuf:"varint,1,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	FailedTransactions int32                  `protobuf:"varint,2,opt,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"`
+	Base               *FeeAmount             `protobuf:"bytes,3,opt,name=base,proto3" json:"base,omitempty"`
+	// Derived from the transactions' ComputeBudget instructions.
+	Priority      *FeeAmount    `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Tips          *FeeAmount    `protobuf:"bytes,5,opt,name=tips,proto3" json:"tips,omitempty"`
+	FailedCost    *FeeAmount    `protobuf:"bytes,6,opt,name=failed_cost,json=failedCost,proto3" json:"failed_cost,omitempty"`
+	Total         *FeeAmount    `protobuf:"bytes,7,opt,name=total,proto3" json:"total,omitempty"`
+	Compute       *ComputeUsage `protobuf:"bytes,8,opt,name=compute,proto3" json:"compute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeTotals) Reset() {
+	*x = FeeTotals{}
 	mi := &file_proto_solana_wallet_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddressTableLookup) String() string {
+func (x *FeeTotals) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddressTableLookup) ProtoMessage() {}
+func (*FeeTotals) ProtoMessage() {}
 
-func (x *AddressTableLookup) ProtoReflect() protoreflect.Message {
+func (x *FeeTotals) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_solana_wallet_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1236,56 +1457,91 @@ func (x *AddressTableLookup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddressTableLookup.ProtoReflect.Descriptor instead.
-func (*AddressTableLookup) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeeTotals.ProtoReflect.Descriptor instead.
+func (*FeeTotals) Descriptor() ([]byte, []int) {
 	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddressTableLookup) GetAccountKey() string {
+func (x *FeeTotals) GetTransactions() int32 {
 	if x != nil {
-		return x.AccountKey
+		return x.Transactions
 	}
-	return ""
+	return 0
 }
 
-func (x *AddressTableLookup) GetReadonlyIndexes() []uint32 {
+func (x *FeeTotals) GetFailedTransactions() int32 {
 	if x != nil {
-		return x.ReadonlyIndexes
+		return x.FailedTransactions
+	}
+	return 0
+}
+
+func (x *FeeTotals) GetBase() *FeeAmount {
+	if x != nil {
+		return x.Base
 	}
 	return nil
 }
 
-func (x *AddressTableLookup) GetWritableIndexes() []uint32 {
+func (x *FeeTotals) GetPriority() *FeeAmount {
 	if x != nil {
-		return x.WritableIndexes
+		return x.Priority
 	}
 	return nil
 }
 
-// Header of a transaction message.
-type MessageHeader struct {
-	state                       protoimpl.MessageState `protogen:"open.v1"`
-	NumReadonlySignedAccounts   uint32                 `protobuf:"varint,1,opt,name=num_readonly_signed_accounts,json=numReadonlySignedAccounts,proto3" json:"num_readonly_signed_accounts,omitempty"`
-	NumReadonlyUnsignedAccounts uint32                 `protobuf:"varint,2,opt,name=num_readonly_unsigned_accounts,json=numReadonlyUnsignedAccounts,proto3" json:"num_readonly_unsigned_accounts,omitempty"`
-	NumRequiredSignatures       uint32                 `protobuf:"varint,3,opt,name=num_required_signatures,json=numRequiredSignatures,proto3" json:"num_required_signatures,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+func (x *FeeTotals) GetTips() *FeeAmount {
+	if x != nil {
+		return x.Tips
+	}
+	return nil
 }
 
-func (x *MessageHeader) Reset() {
-	*x = MessageHeader{}
+func (x *FeeTotals) GetFailedCost() *FeeAmount {
+	if x != nil {
+		return x.FailedCost
+	}
+	return nil
+}
+
+func (x *FeeTotals) GetTotal() *FeeAmount {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *FeeTotals) GetCompute() *ComputeUsage {
+	if x != nil {
+		return x.Compute
+	}
+	return nil
+}
+
+// The fees of transactions in one bucket.
+type FeeBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unix time the bucket starts.
+	Timestamp     int32      `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Totals        *FeeTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeBucket) Reset() {
+	*x = FeeBucket{}
 	mi := &file_proto_solana_wallet_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MessageHeader) String() string {
+func (x *FeeBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageHeader) ProtoMessage() {}
+func (*FeeBucket) ProtoMessage() {}
 
-func (x *MessageHeader) ProtoReflect() protoreflect.Message {
+func (x *FeeBucket) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_solana_wallet_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1297,58 +1553,119 @@ func (x *MessageHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageHeader.ProtoReflect.Descriptor instead.
-func (*MessageHeader) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeeBucket.ProtoReflect.Descriptor instead.
+func (*FeeBucket) Descriptor() ([]byte, []int) {
 	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MessageHeader) GetNumReadonlySignedAccounts() uint32 {
+func (x *FeeBucket) GetTimestamp() int32 {
 	if x != nil {
-		return x.NumReadonlySignedAccounts
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *MessageHeader) GetNumReadonlyUnsignedAccounts() uint32 {
+func (x *FeeBucket) GetTotals() *FeeTotals {
 	if x != nil {
-		return x.NumReadonlyUnsignedAccounts
+		return x.Totals
 	}
-	return 0
+	return nil
 }
 
-func (x *MessageHeader) GetNumRequiredSignatures() uint32 {
+// What one wallet paid as fee payer of its transactions.
+type WalletFees struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Wallet  string                 `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Totals  *FeeTotals             `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	Buckets []*FeeBucket           `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// The failed transactions the wallet paid for, newest first.
+	Failures      []*FailedTransaction `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletFees) Reset() {
+	*x = WalletFees{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletFees) ProtoMessage() {}
+
+func (x *WalletFees) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[18]
 	if x != nil {
-		return x.NumRequiredSignatures
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// An instruction within the transaction.
-type Instruction struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Accounts       []uint32               `protobuf:"varint,1,rep,packed,name=accounts,proto3" json:"accounts,omitempty"`
-	Data           string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	ProgramIdIndex uint32                 `protobuf:"varint,3,opt,name=program_id_index,json=programIdIndex,proto3" json:"program_id_index,omitempty"`
-	StackHeight    uint32                 `protobuf:"varint,4,opt,name=stack_height,json=stackHeight,proto3" json:"stack_height,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// Deprecated: Use WalletFees.ProtoReflect.Descriptor instead.
+func (*WalletFees) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Instruction) Reset() {
-	*x = Instruction{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[18]
+func (x *WalletFees) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *WalletFees) GetTotals() *FeeTotals {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *WalletFees) GetBuckets() []*FeeBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *WalletFees) GetFailures() []*FailedTransaction {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type FeeReport struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Wallets []*WalletFees          `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	// Across every wallet.
+	Totals        *FeeTotals `protobuf:"bytes,2,opt,name=totals,proto3" json:"totals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeReport) Reset() {
+	*x = FeeReport{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Instruction) String() string {
+func (x *FeeReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Instruction) ProtoMessage() {}
+func (*FeeReport) ProtoMessage() {}
 
-func (x *Instruction) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[18]
+func (x *FeeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1359,62 +1676,149 @@ func (x *Instruction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Instruction.ProtoReflect.Descriptor instead.
-func (*Instruction) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use FeeReport.ProtoReflect.Descriptor instead.
+func (*FeeReport) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Instruction) GetAccounts() []uint32 {
+func (x *FeeReport) GetWallets() []*WalletFees {
 	if x != nil {
-		return x.Accounts
+		return x.Wallets
 	}
 	return nil
 }
 
-func (x *Instruction) GetData() string {
+func (x *FeeReport) GetTotals() *FeeTotals {
 	if x != nil {
-		return x.Data
+		return x.Totals
+	}
+	return nil
+}
+
+type FlowGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base58 addresses or .sol domains.
+	WalletAddresses []string `protobuf:"bytes,1,rep,name=wallet_addresses,json=walletAddresses,proto3" json:"wallet_addresses,omitempty"`
+	// Adds every wallet of a saved portfolio.
+	PortfolioId int64 `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// How far back to look, e.g. "7d" or "90d". Defaults to "30d".
+	HistoryRange string `protobuf:"bytes,3,opt,name=history_range,json=historyRange,proto3" json:"history_range,omitempty"`
+	// Hops to expand from the wallets to their counterparties' own
+	// counterparties, 0 to 2. Each expanded address contributes its most
+	// recent transactions only.
+	Depth int32 `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Edges worth less are left out, and so are the addresses only they touch.
+	MinValueUsd float64 `protobuf:"fixed64,5,opt,name=min_value_usd,json=minValueUsd,proto3" json:"min_value_usd,omitempty"`
+	// "json" or "dot" to also render the graph for export.
+	Format        string `protobuf:"bytes,6,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowGraphRequest) Reset() {
+	*x = FlowGraphRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowGraphRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowGraphRequest) ProtoMessage() {}
+
+func (x *FlowGraphRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowGraphRequest.ProtoReflect.Descriptor instead.
+func (*FlowGraphRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FlowGraphRequest) GetWalletAddresses() []string {
+	if x != nil {
+		return x.WalletAddresses
+	}
+	return nil
+}
+
+func (x *FlowGraphRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *FlowGraphRequest) GetHistoryRange() string {
+	if x != nil {
+		return x.HistoryRange
 	}
 	return ""
 }
 
-func (x *Instruction) GetProgramIdIndex() uint32 {
+func (x *FlowGraphRequest) GetDepth() int32 {
 	if x != nil {
-		return x.ProgramIdIndex
+		return x.Depth
 	}
 	return 0
 }
 
-func (x *Instruction) GetStackHeight() uint32 {
+func (x *FlowGraphRequest) GetMinValueUsd() float64 {
 	if x != nil {
-		return x.StackHeight
+		return x.MinValueUsd
 	}
 	return 0
 }
 
-// Status message for transactions.
-type StatusMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+func (x *FlowGraphRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// An address in a flow graph.
+type FlowNode struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Address book label and category, if any.
+	Label    string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// Primary .sol domain, if the address has set one.
+	Domain string `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Set for the requested wallets.
+	Requested bool `protobuf:"varint,5,opt,name=requested,proto3" json:"requested,omitempty"`
+	// Hops from the nearest requested wallet.
+	Depth         int32 `protobuf:"varint,6,opt,name=depth,proto3" json:"depth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatusMessage) Reset() {
-	*x = StatusMessage{}
-	mi := &file_proto_solana_wallet_proto_msgTypes[19]
+func (x *FlowNode) Reset() {
+	*x = FlowNode{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatusMessage) String() string {
+func (x *FlowNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusMessage) ProtoMessage() {}
+func (*FlowNode) ProtoMessage() {}
 
-func (x *StatusMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_solana_wallet_proto_msgTypes[19]
+func (x *FlowNode) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1425,227 +1829,6328 @@ func (x *StatusMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusMessage.ProtoReflect.Descriptor instead.
-func (*StatusMessage) Descriptor() ([]byte, []int) {
-	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use FlowNode.ProtoReflect.Descriptor instead.
+func (*FlowNode) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *StatusMessage) GetStatus() string {
+func (x *FlowNode) GetAddress() string {
 	if x != nil {
-		return x.Status
+		return x.Address
 	}
 	return ""
 }
 
-var File_proto_solana_wallet_proto protoreflect.FileDescriptor
+func (x *FlowNode) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
 
-var file_proto_solana_wallet_proto_rawDesc = string([]byte{
-	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x5f, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x22, 0x36, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3f, 0x0a, 0x12, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xfc, 0x02, 0x0a,
-	0x0e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6c,
-	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
-	0x73, 0x6f, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f,
-	0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73,
-	0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0xae, 0x02, 0x0a, 0x05,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x70, 0x6e, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x70, 0x6e, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x12, 0x39, 0x0a, 0x0e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x69,
-	0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x0d, 0x68,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0x92, 0x01, 0x0a,
-	0x0a, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x67,
-	0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
-	0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x12, 0x31, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f,
-	0x0a, 0x03, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x03, 0x65, 0x72, 0x72, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x35, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a,
-	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
-	0x74, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xde, 0x03, 0x0a,
-	0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65,
-	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66,
-	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x47, 0x0a,
-	0x12, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x11, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f,
-	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6f, 0x73,
-	0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04,
-	0x52, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x21,
-	0x0a, 0x0c, 0x70, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x44, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x08, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x70, 0x72, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x72,
-	0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
-	0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x61, 0x0a,
-	0x10, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x12,
-	0x3b, 0x0a, 0x0f, 0x75, 0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x75,
-	0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a,
-	0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73,
-	0x12, 0x1b, 0x0a, 0x09, 0x75, 0x69, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x69, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a,
-	0x10, 0x75, 0x69, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x69, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x1c, 0x0a, 0x06, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x4b, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x10, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x6f,
-	0x6b, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x67, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x12,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65,
-	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x4e, 0x0a, 0x15, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x52, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a,
-	0x10, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73,
-	0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79,
-	0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64,
-	0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x77,
-	0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x6e, 0x75, 0x6d, 0x5f,
-	0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x19,
-	0x6e, 0x75, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x1e, 0x6e, 0x75, 0x6d,
-	0x5f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x75, 0x6e, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x1b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x55, 0x6e,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x36,
-	0x0a, 0x17, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x73,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x15, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61,
-	0x6d, 0x5f, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x97, 0x01, 0x0a,
+func (x *FlowNode) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *FlowNode) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *FlowNode) GetRequested() bool {
+	if x != nil {
+		return x.Requested
+	}
+	return false
+}
+
+func (x *FlowNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+// Transfers of one asset from one address to another.
+type FlowEdge struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	From   string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Mint   string                 `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	Symbol string                 `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	// USD value at the time of each transfer.
+	Value         float64 `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int32   `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowEdge) Reset() {
+	*x = FlowEdge{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowEdge) ProtoMessage() {}
+
+func (x *FlowEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowEdge.ProtoReflect.Descriptor instead.
+func (*FlowEdge) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FlowEdge) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *FlowEdge) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *FlowEdge) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *FlowEdge) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *FlowEdge) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FlowEdge) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *FlowEdge) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type FlowGraph struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Nodes []*FlowNode            `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Most valuable first.
+	Edges []*FlowEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	// The rendered graph when a format was requested, and a file name for it.
+	Export        []byte `protobuf:"bytes,3,opt,name=export,proto3" json:"export,omitempty"`
+	Filename      string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlowGraph) Reset() {
+	*x = FlowGraph{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlowGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowGraph) ProtoMessage() {}
+
+func (x *FlowGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowGraph.ProtoReflect.Descriptor instead.
+func (*FlowGraph) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *FlowGraph) GetNodes() []*FlowNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *FlowGraph) GetEdges() []*FlowEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *FlowGraph) GetExport() []byte {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+func (x *FlowGraph) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type WalletStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base58 addresses or .sol domains.
+	WalletAddresses []string `protobuf:"bytes,1,rep,name=wallet_addresses,json=walletAddresses,proto3" json:"wallet_addresses,omitempty"`
+	// Adds every wallet of a saved portfolio.
+	PortfolioId int64 `protobuf:"varint,2,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	// How far back to score, e.g. "30d" or "365d". Empty scores the whole
+	// fetched history. Only the range is fetched, so sales of tokens acquired
+	// before it have no known cost basis and are left out of the trades.
+	HistoryRange  string `protobuf:"bytes,3,opt,name=history_range,json=historyRange,proto3" json:"history_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletStatsRequest) Reset() {
+	*x = WalletStatsRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletStatsRequest) ProtoMessage() {}
+
+func (x *WalletStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletStatsRequest.ProtoReflect.Descriptor instead.
+func (*WalletStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WalletStatsRequest) GetWalletAddresses() []string {
+	if x != nil {
+		return x.WalletAddresses
+	}
+	return nil
+}
+
+func (x *WalletStatsRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *WalletStatsRequest) GetHistoryRange() string {
+	if x != nil {
+		return x.HistoryRange
+	}
+	return ""
+}
+
+// A token sold in a swap, against the lots it was bought in, first in
+// first out.
+type TradeResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Signature string                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	// RFC 3339 time.
+	Time     string  `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Symbol   string  `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Mint     string  `protobuf:"bytes,4,opt,name=mint,proto3" json:"mint,omitempty"`
+	Proceeds float64 `protobuf:"fixed64,5,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	Basis    float64 `protobuf:"fixed64,6,opt,name=basis,proto3" json:"basis,omitempty"`
+	Gain     float64 `protobuf:"fixed64,7,opt,name=gain,proto3" json:"gain,omitempty"`
+	// How long the lots were held, weighted by basis.
+	HoldHours     float64 `protobuf:"fixed64,8,opt,name=hold_hours,json=holdHours,proto3" json:"hold_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TradeResult) Reset() {
+	*x = TradeResult{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TradeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TradeResult) ProtoMessage() {}
+
+func (x *TradeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TradeResult.ProtoReflect.Descriptor instead.
+func (*TradeResult) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TradeResult) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *TradeResult) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *TradeResult) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TradeResult) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *TradeResult) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *TradeResult) GetBasis() float64 {
+	if x != nil {
+		return x.Basis
+	}
+	return 0
+}
+
+func (x *TradeResult) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *TradeResult) GetHoldHours() float64 {
+	if x != nil {
+		return x.HoldHours
+	}
+	return 0
+}
+
+// Realised result of trading one token.
+type TokenPnl struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Symbol        string                 `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Mint          string                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Trades        int32                  `protobuf:"varint,3,opt,name=trades,proto3" json:"trades,omitempty"`
+	Wins          int32                  `protobuf:"varint,4,opt,name=wins,proto3" json:"wins,omitempty"`
+	Proceeds      float64                `protobuf:"fixed64,5,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	RealisedPnl   float64                `protobuf:"fixed64,6,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenPnl) Reset() {
+	*x = TokenPnl{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenPnl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenPnl) ProtoMessage() {}
+
+func (x *TokenPnl) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenPnl.ProtoReflect.Descriptor instead.
+func (*TokenPnl) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TokenPnl) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenPnl) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *TokenPnl) GetTrades() int32 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+func (x *TokenPnl) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *TokenPnl) GetProceeds() float64 {
+	if x != nil {
+		return x.Proceeds
+	}
+	return 0
+}
+
+func (x *TokenPnl) GetRealisedPnl() float64 {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return 0
+}
+
+// Swaps made on one venue, e.g. "Jupiter" or "Raydium"; "other" when it is
+// not known.
+type DexVolume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Swaps         int32                  `protobuf:"varint,2,opt,name=swaps,proto3" json:"swaps,omitempty"`
+	Volume        float64                `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DexVolume) Reset() {
+	*x = DexVolume{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DexVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DexVolume) ProtoMessage() {}
+
+func (x *DexVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DexVolume.ProtoReflect.Descriptor instead.
+func (*DexVolume) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DexVolume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DexVolume) GetSwaps() int32 {
+	if x != nil {
+		return x.Swaps
+	}
+	return 0
+}
+
+func (x *DexVolume) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+// Transactions in one hour of the week, in UTC.
+type ActivityCell struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 is Sunday.
+	Weekday       int32 `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Hour          int32 `protobuf:"varint,2,opt,name=hour,proto3" json:"hour,omitempty"`
+	Transactions  int32 `protobuf:"varint,3,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityCell) Reset() {
+	*x = ActivityCell{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityCell) ProtoMessage() {}
+
+func (x *ActivityCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityCell.ProtoReflect.Descriptor instead.
+func (*ActivityCell) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ActivityCell) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *ActivityCell) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *ActivityCell) GetTransactions() int32 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+// A wallet's trading scorecard. A trade is a token other than SOL sold in
+// a swap; buying with SOL is a swap but not a trade.
+type WalletStats struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Wallet string                 `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	Swaps  int32                  `protobuf:"varint,2,opt,name=swaps,proto3" json:"swaps,omitempty"`
+	// USD value of the larger side of each swap, summed.
+	Volume float64 `protobuf:"fixed64,3,opt,name=volume,proto3" json:"volume,omitempty"`
+	Trades int32   `protobuf:"varint,4,opt,name=trades,proto3" json:"trades,omitempty"`
+	Wins   int32   `protobuf:"varint,5,opt,name=wins,proto3" json:"wins,omitempty"`
+	// Trades without a gain, break-even ones included.
+	Losses int32 `protobuf:"varint,6,opt,name=losses,proto3" json:"losses,omitempty"`
+	// Wins over trades, between 0 and 1.
+	WinRate          float64      `protobuf:"fixed64,7,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`
+	AverageHoldHours float64      `protobuf:"fixed64,8,opt,name=average_hold_hours,json=averageHoldHours,proto3" json:"average_hold_hours,omitempty"`
+	RealisedPnl      float64      `protobuf:"fixed64,9,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	BestTrade        *TradeResult `protobuf:"bytes,10,opt,name=best_trade,json=bestTrade,proto3" json:"best_trade,omitempty"`
+	WorstTrade       *TradeResult `protobuf:"bytes,11,opt,name=worst_trade,json=worstTrade,proto3" json:"worst_trade,omitempty"`
+	// Best realised PnL first.
+	Tokens []*TokenPnl `protobuf:"bytes,12,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// Most volume first.
+	Dexes []*DexVolume `protobuf:"bytes,13,rep,name=dexes,proto3" json:"dexes,omitempty"`
+	// Hours of the week with at least one transaction.
+	Activity []*ActivityCell `protobuf:"bytes,14,rep,name=activity,proto3" json:"activity,omitempty"`
+	// Set when the wallet has more history than can be fetched, so its
+	// oldest swaps and lots are missing and the scores are incomplete.
+	Truncated     bool `protobuf:"varint,15,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletStats) Reset() {
+	*x = WalletStats{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletStats) ProtoMessage() {}
+
+func (x *WalletStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletStats.ProtoReflect.Descriptor instead.
+func (*WalletStats) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *WalletStats) GetWallet() string {
+	if x != nil {
+		return x.Wallet
+	}
+	return ""
+}
+
+func (x *WalletStats) GetSwaps() int32 {
+	if x != nil {
+		return x.Swaps
+	}
+	return 0
+}
+
+func (x *WalletStats) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *WalletStats) GetTrades() int32 {
+	if x != nil {
+		return x.Trades
+	}
+	return 0
+}
+
+func (x *WalletStats) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+func (x *WalletStats) GetLosses() int32 {
+	if x != nil {
+		return x.Losses
+	}
+	return 0
+}
+
+func (x *WalletStats) GetWinRate() float64 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *WalletStats) GetAverageHoldHours() float64 {
+	if x != nil {
+		return x.AverageHoldHours
+	}
+	return 0
+}
+
+func (x *WalletStats) GetRealisedPnl() float64 {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return 0
+}
+
+func (x *WalletStats) GetBestTrade() *TradeResult {
+	if x != nil {
+		return x.BestTrade
+	}
+	return nil
+}
+
+func (x *WalletStats) GetWorstTrade() *TradeResult {
+	if x != nil {
+		return x.WorstTrade
+	}
+	return nil
+}
+
+func (x *WalletStats) GetTokens() []*TokenPnl {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *WalletStats) GetDexes() []*DexVolume {
+	if x != nil {
+		return x.Dexes
+	}
+	return nil
+}
+
+func (x *WalletStats) GetActivity() []*ActivityCell {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+func (x *WalletStats) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type WalletStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallets       []*WalletStats         `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletStatsResponse) Reset() {
+	*x = WalletStatsResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletStatsResponse) ProtoMessage() {}
+
+func (x *WalletStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletStatsResponse.ProtoReflect.Descriptor instead.
+func (*WalletStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *WalletStatsResponse) GetWallets() []*WalletStats {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+// Top‐level response message.
+type WalletResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Address           string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SolBalance        float64                `protobuf:"fixed64,2,opt,name=sol_balance,json=solBalance,proto3" json:"sol_balance,omitempty"`
+	SolValue          float64                `protobuf:"fixed64,3,opt,name=sol_value,json=solValue,proto3" json:"sol_value,omitempty"`
+	WalletValue       float64                `protobuf:"fixed64,4,opt,name=wallet_value,json=walletValue,proto3" json:"wallet_value,omitempty"`
+	Tokens            []*Token               `protobuf:"bytes,5,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Transactions      []*Transaction         `protobuf:"bytes,6,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	LastUpdated       string                 `protobuf:"bytes,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	TokenAmount       int32                  `protobuf:"varint,8,opt,name=token_amount,json=tokenAmount,proto3" json:"token_amount,omitempty"`
+	TransactionAmount int32                  `protobuf:"varint,9,opt,name=transaction_amount,json=transactionAmount,proto3" json:"transaction_amount,omitempty"`
+	Progress          float64                `protobuf:"fixed64,10,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Spam and dust tokens, only filled when the request sets hide_spam.
+	// They are not part of wallet_value.
+	SpamTokens []*Token `protobuf:"bytes,11,rep,name=spam_tokens,json=spamTokens,proto3" json:"spam_tokens,omitempty"`
+	// NFTs, including compressed NFTs, kept out of tokens.
+	Nfts           []*Nft           `protobuf:"bytes,12,rep,name=nfts,proto3" json:"nfts,omitempty"`
+	NftCollections []*NftCollection `protobuf:"bytes,13,rep,name=nft_collections,json=nftCollections,proto3" json:"nft_collections,omitempty"`
+	// Floor value of all NFTs in USD, included in wallet_value.
+	NftValue float64 `protobuf:"fixed64,14,opt,name=nft_value,json=nftValue,proto3" json:"nft_value,omitempty"`
+	// Native stake accounts controlled by the wallet.
+	StakeAccounts []*StakeAccount `protobuf:"bytes,15,rep,name=stake_accounts,json=stakeAccounts,proto3" json:"stake_accounts,omitempty"`
+	// SOL held in stake accounts and its USD value, included in wallet_value.
+	StakedSol   float64 `protobuf:"fixed64,16,opt,name=staked_sol,json=stakedSol,proto3" json:"staked_sol,omitempty"`
+	StakedValue float64 `protobuf:"fixed64,17,opt,name=staked_value,json=stakedValue,proto3" json:"staked_value,omitempty"`
+	// Inflation rewards over the requested epochs, in SOL.
+	StakingRewards float64 `protobuf:"fixed64,18,opt,name=staking_rewards,json=stakingRewards,proto3" json:"staking_rewards,omitempty"`
+	// LP tokens and concentrated liquidity positions, valued by their underlying tokens.
+	Positions     []*Position `protobuf:"bytes,19,rep,name=positions,proto3" json:"positions,omitempty"`
+	PositionValue float64     `protobuf:"fixed64,20,opt,name=position_value,json=positionValue,proto3" json:"position_value,omitempty"`
+	// Deposits and borrows in lending protocols.
+	LendingPositions []*LendingPosition `protobuf:"bytes,21,rep,name=lending_positions,json=lendingPositions,proto3" json:"lending_positions,omitempty"`
+	// Deposits minus borrows across lending positions; included in wallet_value.
+	LendingValue float64 `protobuf:"fixed64,22,opt,name=lending_value,json=lendingValue,proto3" json:"lending_value,omitempty"`
+	// Primary .sol domain of the wallet, if it has set one.
+	Domain string `protobuf:"bytes,23,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Airdrops and other income among the fetched transactions, valued at
+	// receipt, and their total.
+	IncomeValue float64       `protobuf:"fixed64,24,opt,name=income_value,json=incomeValue,proto3" json:"income_value,omitempty"`
+	Income      []*IncomeItem `protobuf:"bytes,25,rep,name=income,proto3" json:"income,omitempty"`
+	// Transactions that failed, newest first. They are left out of balance
+	// and cost basis calculations.
+	FailedTransactions []*FailedTransaction `protobuf:"bytes,26,rep,name=failed_transactions,json=failedTransactions,proto3" json:"failed_transactions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *WalletResponse) Reset() {
+	*x = WalletResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletResponse) ProtoMessage() {}
+
+func (x *WalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletResponse.ProtoReflect.Descriptor instead.
+func (*WalletResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *WalletResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WalletResponse) GetSolBalance() float64 {
+	if x != nil {
+		return x.SolBalance
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetSolValue() float64 {
+	if x != nil {
+		return x.SolValue
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetWalletValue() float64 {
+	if x != nil {
+		return x.WalletValue
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetLastUpdated() string {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return ""
+}
+
+func (x *WalletResponse) GetTokenAmount() int32 {
+	if x != nil {
+		return x.TokenAmount
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetTransactionAmount() int32 {
+	if x != nil {
+		return x.TransactionAmount
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetSpamTokens() []*Token {
+	if x != nil {
+		return x.SpamTokens
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetNfts() []*Nft {
+	if x != nil {
+		return x.Nfts
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetNftCollections() []*NftCollection {
+	if x != nil {
+		return x.NftCollections
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetNftValue() float64 {
+	if x != nil {
+		return x.NftValue
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetStakeAccounts() []*StakeAccount {
+	if x != nil {
+		return x.StakeAccounts
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetStakedSol() float64 {
+	if x != nil {
+		return x.StakedSol
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetStakedValue() float64 {
+	if x != nil {
+		return x.StakedValue
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetStakingRewards() float64 {
+	if x != nil {
+		return x.StakingRewards
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetPositions() []*Position {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetPositionValue() float64 {
+	if x != nil {
+		return x.PositionValue
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetLendingPositions() []*LendingPosition {
+	if x != nil {
+		return x.LendingPositions
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetLendingValue() float64 {
+	if x != nil {
+		return x.LendingValue
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *WalletResponse) GetIncomeValue() float64 {
+	if x != nil {
+		return x.IncomeValue
+	}
+	return 0
+}
+
+func (x *WalletResponse) GetIncome() []*IncomeItem {
+	if x != nil {
+		return x.Income
+	}
+	return nil
+}
+
+func (x *WalletResponse) GetFailedTransactions() []*FailedTransaction {
+	if x != nil {
+		return x.FailedTransactions
+	}
+	return nil
+}
+
+// Token information.
+type Token struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Pool          string                 `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	Pnl           float64                `protobuf:"fixed64,8,opt,name=pnl,proto3" json:"pnl,omitempty"`
+	Invested      float64                `protobuf:"fixed64,9,opt,name=invested,proto3" json:"invested,omitempty"`
+	Value         float64                `protobuf:"fixed64,10,opt,name=value,proto3" json:"value,omitempty"`
+	HistoryPrices []*PricePoint          `protobuf:"bytes,11,rep,name=history_prices,json=historyPrices,proto3" json:"history_prices,omitempty"`
+	// Candidate pools ranked by liquidity; pool is the address of the first one.
+	Pools []*TokenPool `protobuf:"bytes,12,rep,name=pools,proto3" json:"pools,omitempty"`
+	// Original image uri from the token metadata; image points at the cached thumbnail.
+	ImageSource string `protobuf:"bytes,13,opt,name=image_source,json=imageSource,proto3" json:"image_source,omitempty"`
+	Symbol      string `protobuf:"bytes,14,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals    int32  `protobuf:"varint,15,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// Metaplex token standard, e.g. "Fungible" or "NonFungible".
+	TokenStandard string `protobuf:"bytes,16,opt,name=token_standard,json=tokenStandard,proto3" json:"token_standard,omitempty"`
+	// Empty once the authority has been revoked.
+	MintAuthority   string `protobuf:"bytes,17,opt,name=mint_authority,json=mintAuthority,proto3" json:"mint_authority,omitempty"`
+	FreezeAuthority string `protobuf:"bytes,18,opt,name=freeze_authority,json=freezeAuthority,proto3" json:"freeze_authority,omitempty"`
+	// Whether the mint is on the configured verified token list.
+	Verified    bool     `protobuf:"varint,19,opt,name=verified,proto3" json:"verified,omitempty"`
+	Spam        bool     `protobuf:"varint,20,opt,name=spam,proto3" json:"spam,omitempty"`
+	SpamReasons []string `protobuf:"bytes,21,rep,name=spam_reasons,json=spamReasons,proto3" json:"spam_reasons,omitempty"`
+	// Set for liquid staking tokens, which are priced from their stake pool.
+	LiquidStake *LiquidStake `protobuf:"bytes,22,opt,name=liquid_stake,json=liquidStake,proto3" json:"liquid_stake,omitempty"`
+	// "airdrop", "income", "gift" or "spam" when the holding was received
+	// without giving anything in return; invested is then its value at
+	// receipt, or 0 for spam.
+	Acquisition   string `protobuf:"bytes,23,opt,name=acquisition,proto3" json:"acquisition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Token) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Token) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Token) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *Token) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Token) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Token) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Token) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Token) GetPnl() float64 {
+	if x != nil {
+		return x.Pnl
+	}
+	return 0
+}
+
+func (x *Token) GetInvested() float64 {
+	if x != nil {
+		return x.Invested
+	}
+	return 0
+}
+
+func (x *Token) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Token) GetHistoryPrices() []*PricePoint {
+	if x != nil {
+		return x.HistoryPrices
+	}
+	return nil
+}
+
+func (x *Token) GetPools() []*TokenPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *Token) GetImageSource() string {
+	if x != nil {
+		return x.ImageSource
+	}
+	return ""
+}
+
+func (x *Token) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Token) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Token) GetTokenStandard() string {
+	if x != nil {
+		return x.TokenStandard
+	}
+	return ""
+}
+
+func (x *Token) GetMintAuthority() string {
+	if x != nil {
+		return x.MintAuthority
+	}
+	return ""
+}
+
+func (x *Token) GetFreezeAuthority() string {
+	if x != nil {
+		return x.FreezeAuthority
+	}
+	return ""
+}
+
+func (x *Token) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *Token) GetSpam() bool {
+	if x != nil {
+		return x.Spam
+	}
+	return false
+}
+
+func (x *Token) GetSpamReasons() []string {
+	if x != nil {
+		return x.SpamReasons
+	}
+	return nil
+}
+
+func (x *Token) GetLiquidStake() *LiquidStake {
+	if x != nil {
+		return x.LiquidStake
+	}
+	return nil
+}
+
+func (x *Token) GetAcquisition() string {
+	if x != nil {
+		return x.Acquisition
+	}
+	return ""
+}
+
+// How a liquid staking token is backed.
+type LiquidStake struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stake pool account, or "marinade" for mSOL.
+	StakePool string `protobuf:"bytes,1,opt,name=stake_pool,json=stakePool,proto3" json:"stake_pool,omitempty"`
+	// SOL backing one token.
+	ExchangeRate float64 `protobuf:"fixed64,2,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	// Annualized yield as a fraction, from the last epoch's exchange rate change.
+	Apy           float64 `protobuf:"fixed64,3,opt,name=apy,proto3" json:"apy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiquidStake) Reset() {
+	*x = LiquidStake{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiquidStake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidStake) ProtoMessage() {}
+
+func (x *LiquidStake) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiquidStake.ProtoReflect.Descriptor instead.
+func (*LiquidStake) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *LiquidStake) GetStakePool() string {
+	if x != nil {
+		return x.StakePool
+	}
+	return ""
+}
+
+func (x *LiquidStake) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *LiquidStake) GetApy() float64 {
+	if x != nil {
+		return x.Apy
+	}
+	return 0
+}
+
+// A liquidity pool trading a token.
+type TokenPool struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Address        string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Dex            string                 `protobuf:"bytes,3,opt,name=dex,proto3" json:"dex,omitempty"`
+	QuoteToken     string                 `protobuf:"bytes,4,opt,name=quote_token,json=quoteToken,proto3" json:"quote_token,omitempty"`
+	ReserveInUsd   float64                `protobuf:"fixed64,5,opt,name=reserve_in_usd,json=reserveInUsd,proto3" json:"reserve_in_usd,omitempty"`
+	DailyVolumeUsd float64                `protobuf:"fixed64,6,opt,name=daily_volume_usd,json=dailyVolumeUsd,proto3" json:"daily_volume_usd,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TokenPool) Reset() {
+	*x = TokenPool{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenPool) ProtoMessage() {}
+
+func (x *TokenPool) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenPool.ProtoReflect.Descriptor instead.
+func (*TokenPool) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TokenPool) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TokenPool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TokenPool) GetDex() string {
+	if x != nil {
+		return x.Dex
+	}
+	return ""
+}
+
+func (x *TokenPool) GetQuoteToken() string {
+	if x != nil {
+		return x.QuoteToken
+	}
+	return ""
+}
+
+func (x *TokenPool) GetReserveInUsd() float64 {
+	if x != nil {
+		return x.ReserveInUsd
+	}
+	return 0
+}
+
+func (x *TokenPool) GetDailyVolumeUsd() float64 {
+	if x != nil {
+		return x.DailyVolumeUsd
+	}
+	return 0
+}
+
+// A non-fungible token held by the wallet.
+type Nft struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Mint of a regular NFT, asset id of a compressed one.
+	Mint               string `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Name               string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol             string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Image              string `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+	Collection         string `protobuf:"bytes,5,opt,name=collection,proto3" json:"collection,omitempty"`
+	CollectionName     string `protobuf:"bytes,6,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionVerified bool   `protobuf:"varint,7,opt,name=collection_verified,json=collectionVerified,proto3" json:"collection_verified,omitempty"`
+	Compressed         bool   `protobuf:"varint,8,opt,name=compressed,proto3" json:"compressed,omitempty"`
+	// Collection floor price in SOL, 0 when unknown.
+	FloorPrice float64 `protobuf:"fixed64,9,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	// Floor price in USD.
+	Value         float64 `protobuf:"fixed64,10,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Nft) Reset() {
+	*x = Nft{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nft) ProtoMessage() {}
+
+func (x *Nft) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nft.ProtoReflect.Descriptor instead.
+func (*Nft) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Nft) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *Nft) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Nft) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Nft) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Nft) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *Nft) GetCollectionName() string {
+	if x != nil {
+		return x.CollectionName
+	}
+	return ""
+}
+
+func (x *Nft) GetCollectionVerified() bool {
+	if x != nil {
+		return x.CollectionVerified
+	}
+	return false
+}
+
+func (x *Nft) GetCompressed() bool {
+	if x != nil {
+		return x.Compressed
+	}
+	return false
+}
+
+func (x *Nft) GetFloorPrice() float64 {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return 0
+}
+
+func (x *Nft) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// The NFTs of one collection.
+type NftCollection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Collection mint, empty for NFTs without a collection.
+	Address       string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name          string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	FloorPrice    float64 `protobuf:"fixed64,4,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price,omitempty"`
+	Value         float64 `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NftCollection) Reset() {
+	*x = NftCollection{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NftCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NftCollection) ProtoMessage() {}
+
+func (x *NftCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NftCollection.ProtoReflect.Descriptor instead.
+func (*NftCollection) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NftCollection) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NftCollection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NftCollection) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NftCollection) GetFloorPrice() float64 {
+	if x != nil {
+		return x.FloorPrice
+	}
+	return 0
+}
+
+func (x *NftCollection) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// A native stake account.
+type StakeAccount struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// All SOL in the account, including the rent exempt reserve.
+	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Delegated stake in SOL.
+	Delegated float64 `protobuf:"fixed64,3,opt,name=delegated,proto3" json:"delegated,omitempty"`
+	// Vote account of the validator the stake is delegated to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+	// "activating", "active", "deactivating" or "inactive".
+	State           string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	ActivationEpoch uint64 `protobuf:"varint,6,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	// 0 unless the stake is deactivating or deactivated.
+	DeactivationEpoch uint64         `protobuf:"varint,7,opt,name=deactivation_epoch,json=deactivationEpoch,proto3" json:"deactivation_epoch,omitempty"`
+	Staker            string         `protobuf:"bytes,8,opt,name=staker,proto3" json:"staker,omitempty"`
+	Withdrawer        string         `protobuf:"bytes,9,opt,name=withdrawer,proto3" json:"withdrawer,omitempty"`
+	Rewards           []*StakeReward `protobuf:"bytes,10,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	// Sum of rewards in SOL.
+	TotalRewards  float64 `protobuf:"fixed64,11,opt,name=total_rewards,json=totalRewards,proto3" json:"total_rewards,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakeAccount) Reset() {
+	*x = StakeAccount{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakeAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeAccount) ProtoMessage() {}
+
+func (x *StakeAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeAccount.ProtoReflect.Descriptor instead.
+func (*StakeAccount) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StakeAccount) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StakeAccount) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *StakeAccount) GetDelegated() float64 {
+	if x != nil {
+		return x.Delegated
+	}
+	return 0
+}
+
+func (x *StakeAccount) GetValidator() string {
+	if x != nil {
+		return x.Validator
+	}
+	return ""
+}
+
+func (x *StakeAccount) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *StakeAccount) GetActivationEpoch() uint64 {
+	if x != nil {
+		return x.ActivationEpoch
+	}
+	return 0
+}
+
+func (x *StakeAccount) GetDeactivationEpoch() uint64 {
+	if x != nil {
+		return x.DeactivationEpoch
+	}
+	return 0
+}
+
+func (x *StakeAccount) GetStaker() string {
+	if x != nil {
+		return x.Staker
+	}
+	return ""
+}
+
+func (x *StakeAccount) GetWithdrawer() string {
+	if x != nil {
+		return x.Withdrawer
+	}
+	return ""
+}
+
+func (x *StakeAccount) GetRewards() []*StakeReward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *StakeAccount) GetTotalRewards() float64 {
+	if x != nil {
+		return x.TotalRewards
+	}
+	return 0
+}
+
+// An inflation reward paid to a stake account at the end of an epoch.
+type StakeReward struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Epoch uint64                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Reward in SOL.
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Account balance after the reward in SOL.
+	PostBalance float64 `protobuf:"fixed64,3,opt,name=post_balance,json=postBalance,proto3" json:"post_balance,omitempty"`
+	// Validator commission in percent.
+	Commission int32 `protobuf:"varint,4,opt,name=commission,proto3" json:"commission,omitempty"`
+	// Reward in USD at the current SOL price.
+	Value         float64 `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StakeReward) Reset() {
+	*x = StakeReward{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StakeReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StakeReward) ProtoMessage() {}
+
+func (x *StakeReward) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StakeReward.ProtoReflect.Descriptor instead.
+func (*StakeReward) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StakeReward) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *StakeReward) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StakeReward) GetPostBalance() float64 {
+	if x != nil {
+		return x.PostBalance
+	}
+	return 0
+}
+
+func (x *StakeReward) GetCommission() int32 {
+	if x != nil {
+		return x.Commission
+	}
+	return 0
+}
+
+func (x *StakeReward) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type PricePoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int32                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Open          float64                `protobuf:"fixed64,2,opt,name=open,proto3" json:"open,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Low           float64                `protobuf:"fixed64,4,opt,name=low,proto3" json:"low,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricePoint) Reset() {
+	*x = PricePoint{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricePoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricePoint) ProtoMessage() {}
+
+func (x *PricePoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricePoint.ProtoReflect.Descriptor instead.
+func (*PricePoint) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PricePoint) GetTimestamp() int32 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *PricePoint) GetOpen() float64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *PricePoint) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
+}
+
+func (x *PricePoint) GetLow() float64 {
+	if x != nil {
+		return x.Low
+	}
+	return 0
+}
+
+func (x *PricePoint) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
+}
+
+func (x *PricePoint) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+// A transaction container.
+type Transaction struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Jsonrpc string                 `protobuf:"bytes,1,opt,name=jsonrpc,proto3" json:"jsonrpc,omitempty"`
+	Result  *TransactionResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Err     *Error                 `protobuf:"bytes,3,opt,name=err,proto3" json:"err,omitempty"`
+	Id      int32                  `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// Other wallets whose balances the transaction changed.
+	Counterparties []*Counterparty `protobuf:"bytes,5,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+	// Programs invoked by the transaction's top-level instructions.
+	Programs []*Counterparty `protobuf:"bytes,6,rep,name=programs,proto3" json:"programs,omitempty"`
+	// Set when the transaction failed. It then only charged its fee.
+	Failed bool `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	// The program that failed it and why, decoded from its log messages.
+	FailedProgram string `protobuf:"bytes,8,opt,name=failed_program,json=failedProgram,proto3" json:"failed_program,omitempty"`
+	FailureReason string `protobuf:"bytes,9,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Set when the transaction moved funds between two of the request's
+	// owned wallets. It neither buys nor sells, so cost basis carries over.
+	InternalTransfer bool `protobuf:"varint,10,opt,name=internal_transfer,json=internalTransfer,proto3" json:"internal_transfer,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Transaction) GetJsonrpc() string {
+	if x != nil {
+		return x.Jsonrpc
+	}
+	return ""
+}
+
+func (x *Transaction) GetResult() *TransactionResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Transaction) GetErr() *Error {
+	if x != nil {
+		return x.Err
+	}
+	return nil
+}
+
+func (x *Transaction) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transaction) GetCounterparties() []*Counterparty {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+func (x *Transaction) GetPrograms() []*Counterparty {
+	if x != nil {
+		return x.Programs
+	}
+	return nil
+}
+
+func (x *Transaction) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *Transaction) GetFailedProgram() string {
+	if x != nil {
+		return x.FailedProgram
+	}
+	return ""
+}
+
+func (x *Transaction) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Transaction) GetInternalTransfer() bool {
+	if x != nil {
+		return x.InternalTransfer
+	}
+	return false
+}
+
+// A transaction that failed. It moved no funds but still charged its fee.
+type FailedTransaction struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Signature string                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	BlockTime int64                  `protobuf:"varint,2,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	Slot      uint64                 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	// Fee charged in SOL; 0 when the transaction was not fetched.
+	Fee float64 `protobuf:"fixed64,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Empty when the transaction was not fetched or its logs do not say.
+	Program string `protobuf:"bytes,5,opt,name=program,proto3" json:"program,omitempty"`
+	// Decoded from the program's log messages when fetched, otherwise the
+	// transaction error, e.g. "instruction 2: custom program error 6001".
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailedTransaction) Reset() {
+	*x = FailedTransaction{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailedTransaction) ProtoMessage() {}
+
+func (x *FailedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailedTransaction.ProtoReflect.Descriptor instead.
+func (*FailedTransaction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FailedTransaction) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *FailedTransaction) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *FailedTransaction) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *FailedTransaction) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *FailedTransaction) GetProgram() string {
+	if x != nil {
+		return x.Program
+	}
+	return ""
+}
+
+func (x *FailedTransaction) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// A wallet on the other side of a transaction.
+type Counterparty struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Primary .sol domain, if the wallet has set one.
+	Domain string `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	// Address book label, e.g. "Binance hot wallet".
+	Label    string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Category string `protobuf:"bytes,4,opt,name=category,proto3" json:"category,omitempty"`
+	// "user" for user labels, "known" for known entities.
+	LabelSource   string `protobuf:"bytes,5,opt,name=label_source,json=labelSource,proto3" json:"label_source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Counterparty) Reset() {
+	*x = Counterparty{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Counterparty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counterparty) ProtoMessage() {}
+
+func (x *Counterparty) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counterparty.ProtoReflect.Descriptor instead.
+func (*Counterparty) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Counterparty) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Counterparty) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Counterparty) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Counterparty) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Counterparty) GetLabelSource() string {
+	if x != nil {
+		return x.LabelSource
+	}
+	return ""
+}
+
+type Error struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Error) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// The detailed transaction result.
+type TransactionResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockTime     int64                  `protobuf:"varint,1,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	Meta          *Meta                  `protobuf:"bytes,2,opt,name=meta,proto3" json:"meta,omitempty"`
+	Slot          uint64                 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Transaction   *TransactionData       `protobuf:"bytes,4,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionResult) Reset() {
+	*x = TransactionResult{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionResult) ProtoMessage() {}
+
+func (x *TransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionResult.ProtoReflect.Descriptor instead.
+func (*TransactionResult) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TransactionResult) GetBlockTime() int64 {
+	if x != nil {
+		return x.BlockTime
+	}
+	return 0
+}
+
+func (x *TransactionResult) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *TransactionResult) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *TransactionResult) GetTransaction() *TransactionData {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// Meta data for a transaction.
+type Meta struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ComputeUnitsConsumed uint64                 `protobuf:"varint,1,opt,name=compute_units_consumed,json=computeUnitsConsumed,proto3" json:"compute_units_consumed,omitempty"`
+	Fee                  uint64                 `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	InnerInstructions    []*InnerInstruction    `protobuf:"bytes,3,rep,name=inner_instructions,json=innerInstructions,proto3" json:"inner_instructions,omitempty"`
+	LogMessages          []string               `protobuf:"bytes,4,rep,name=log_messages,json=logMessages,proto3" json:"log_messages,omitempty"`
+	PostBalances         []uint64               `protobuf:"varint,5,rep,packed,name=post_balances,json=postBalances,proto3" json:"post_balances,omitempty"`
+	PreBalances          []uint64               `protobuf:"varint,6,rep,packed,name=pre_balances,json=preBalances,proto3" json:"pre_balances,omitempty"`
+	PostTokenBalances    []*TokenBalance        `protobuf:"bytes,7,rep,name=post_token_balances,json=postTokenBalances,proto3" json:"post_token_balances,omitempty"`
+	PreTokenBalances     []*TokenBalance        `protobuf:"bytes,8,rep,name=pre_token_balances,json=preTokenBalances,proto3" json:"pre_token_balances,omitempty"`
+	Rewards              []*Reward              `protobuf:"bytes,9,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Status               *Status                `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Meta) Reset() {
+	*x = Meta{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meta) ProtoMessage() {}
+
+func (x *Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
+func (*Meta) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Meta) GetComputeUnitsConsumed() uint64 {
+	if x != nil {
+		return x.ComputeUnitsConsumed
+	}
+	return 0
+}
+
+func (x *Meta) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *Meta) GetInnerInstructions() []*InnerInstruction {
+	if x != nil {
+		return x.InnerInstructions
+	}
+	return nil
+}
+
+func (x *Meta) GetLogMessages() []string {
+	if x != nil {
+		return x.LogMessages
+	}
+	return nil
+}
+
+func (x *Meta) GetPostBalances() []uint64 {
+	if x != nil {
+		return x.PostBalances
+	}
+	return nil
+}
+
+func (x *Meta) GetPreBalances() []uint64 {
+	if x != nil {
+		return x.PreBalances
+	}
+	return nil
+}
+
+func (x *Meta) GetPostTokenBalances() []*TokenBalance {
+	if x != nil {
+		return x.PostTokenBalances
+	}
+	return nil
+}
+
+func (x *Meta) GetPreTokenBalances() []*TokenBalance {
+	if x != nil {
+		return x.PreTokenBalances
+	}
+	return nil
+}
+
+func (x *Meta) GetRewards() []*Reward {
+	if x != nil {
+		return x.Rewards
+	}
+	return nil
+}
+
+func (x *Meta) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Inner instructions.
+type InnerInstruction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Instructions  []*Instruction         `protobuf:"bytes,2,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InnerInstruction) Reset() {
+	*x = InnerInstruction{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InnerInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InnerInstruction) ProtoMessage() {}
+
+func (x *InnerInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InnerInstruction.ProtoReflect.Descriptor instead.
+func (*InnerInstruction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *InnerInstruction) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *InnerInstruction) GetInstructions() []*Instruction {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+// A token balance record.
+type TokenBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountIndex  uint32                 `protobuf:"varint,1,opt,name=account_index,json=accountIndex,proto3" json:"account_index,omitempty"`
+	Mint          string                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Owner         string                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	ProgramId     string                 `protobuf:"bytes,4,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	UiTokenAmount *TokenAmount           `protobuf:"bytes,5,opt,name=ui_token_amount,json=uiTokenAmount,proto3" json:"ui_token_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenBalance) Reset() {
+	*x = TokenBalance{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenBalance) ProtoMessage() {}
+
+func (x *TokenBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenBalance.ProtoReflect.Descriptor instead.
+func (*TokenBalance) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TokenBalance) GetAccountIndex() uint32 {
+	if x != nil {
+		return x.AccountIndex
+	}
+	return 0
+}
+
+func (x *TokenBalance) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *TokenBalance) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *TokenBalance) GetProgramId() string {
+	if x != nil {
+		return x.ProgramId
+	}
+	return ""
+}
+
+func (x *TokenBalance) GetUiTokenAmount() *TokenAmount {
+	if x != nil {
+		return x.UiTokenAmount
+	}
+	return nil
+}
+
+// Token amount details.
+type TokenAmount struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Amount         string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals       uint32                 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	UiAmount       float64                `protobuf:"fixed64,3,opt,name=ui_amount,json=uiAmount,proto3" json:"ui_amount,omitempty"`
+	UiAmountString string                 `protobuf:"bytes,4,opt,name=ui_amount_string,json=uiAmountString,proto3" json:"ui_amount_string,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *TokenAmount) Reset() {
+	*x = TokenAmount{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenAmount) ProtoMessage() {}
+
+func (x *TokenAmount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenAmount.ProtoReflect.Descriptor instead.
+func (*TokenAmount) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TokenAmount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TokenAmount) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *TokenAmount) GetUiAmount() float64 {
+	if x != nil {
+		return x.UiAmount
+	}
+	return 0
+}
+
+func (x *TokenAmount) GetUiAmountString() string {
+	if x != nil {
+		return x.UiAmountString
+	}
+	return ""
+}
+
+// Reward information.
+type Reward struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          string                 `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reward) Reset() {
+	*x = Reward{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reward) ProtoMessage() {}
+
+func (x *Reward) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reward.ProtoReflect.Descriptor instead.
+func (*Reward) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Reward) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+// Status structure.
+type Status struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*Status_Ok
+	//	*Status_ErrorMessage
+	Result        isStatus_Result `protobuf_oneof:"result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Status) GetResult() isStatus_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *Status) GetOk() string {
+	if x != nil {
+		if x, ok := x.Result.(*Status_Ok); ok {
+			return x.Ok
+		}
+	}
+	return ""
+}
+
+func (x *Status) GetErrorMessage() string {
+	if x != nil {
+		if x, ok := x.Result.(*Status_ErrorMessage); ok {
+			return x.ErrorMessage
+		}
+	}
+	return ""
+}
+
+type isStatus_Result interface {
+	isStatus_Result()
+}
+
+type Status_Ok struct {
+	Ok string `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type Status_ErrorMessage struct {
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof"`
+}
+
+func (*Status_Ok) isStatus_Result() {}
+
+func (*Status_ErrorMessage) isStatus_Result() {}
+
+// The actual transaction data.
+type TransactionData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       *TransactionMessage    `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Signatures    []string               `protobuf:"bytes,2,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionData) Reset() {
+	*x = TransactionData{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionData) ProtoMessage() {}
+
+func (x *TransactionData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionData.ProtoReflect.Descriptor instead.
+func (*TransactionData) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TransactionData) GetMessage() *TransactionMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *TransactionData) GetSignatures() []string {
+	if x != nil {
+		return x.Signatures
+	}
+	return nil
+}
+
+// The transaction message details.
+type TransactionMessage struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	AccountKeys         []string               `protobuf:"bytes,1,rep,name=account_keys,json=accountKeys,proto3" json:"account_keys,omitempty"`
+	AddressTableLookups []*AddressTableLookup  `protobuf:"bytes,2,rep,name=address_table_lookups,json=addressTableLookups,proto3" json:"address_table_lookups,omitempty"`
+	Header              *MessageHeader         `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	Instructions        []*Instruction         `protobuf:"bytes,4,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	RecentBlockhash     string                 `protobuf:"bytes,5,opt,name=recent_blockhash,json=recentBlockhash,proto3" json:"recent_blockhash,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *TransactionMessage) Reset() {
+	*x = TransactionMessage{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionMessage) ProtoMessage() {}
+
+func (x *TransactionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionMessage.ProtoReflect.Descriptor instead.
+func (*TransactionMessage) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TransactionMessage) GetAccountKeys() []string {
+	if x != nil {
+		return x.AccountKeys
+	}
+	return nil
+}
+
+func (x *TransactionMessage) GetAddressTableLookups() []*AddressTableLookup {
+	if x != nil {
+		return x.AddressTableLookups
+	}
+	return nil
+}
+
+func (x *TransactionMessage) GetHeader() *MessageHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TransactionMessage) GetInstructions() []*Instruction {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+func (x *TransactionMessage) GetRecentBlockhash() string {
+	if x != nil {
+		return x.RecentBlockhash
+	}
+	return ""
+}
+
+// Address table lookups.
+type AddressTableLookup struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccountKey      string                 `protobuf:"bytes,1,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`
+	ReadonlyIndexes []uint32               `protobuf:"varint,2,rep,packed,name=readonly_indexes,json=readonlyIndexes,proto3" json:"readonly_indexes,omitempty"`
+	WritableIndexes []uint32               `protobuf:"varint,3,rep,packed,name=writable_indexes,json=writableIndexes,proto3" json:"writable_indexes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddressTableLookup) Reset() {
+	*x = AddressTableLookup{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressTableLookup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressTableLookup) ProtoMessage() {}
+
+func (x *AddressTableLookup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressTableLookup.ProtoReflect.Descriptor instead.
+func (*AddressTableLookup) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AddressTableLookup) GetAccountKey() string {
+	if x != nil {
+		return x.AccountKey
+	}
+	return ""
+}
+
+func (x *AddressTableLookup) GetReadonlyIndexes() []uint32 {
+	if x != nil {
+		return x.ReadonlyIndexes
+	}
+	return nil
+}
+
+func (x *AddressTableLookup) GetWritableIndexes() []uint32 {
+	if x != nil {
+		return x.WritableIndexes
+	}
+	return nil
+}
+
+// Header of a transaction message.
+type MessageHeader struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	NumReadonlySignedAccounts   uint32                 `protobuf:"varint,1,opt,name=num_readonly_signed_accounts,json=numReadonlySignedAccounts,proto3" json:"num_readonly_signed_accounts,omitempty"`
+	NumReadonlyUnsignedAccounts uint32                 `protobuf:"varint,2,opt,name=num_readonly_unsigned_accounts,json=numReadonlyUnsignedAccounts,proto3" json:"num_readonly_unsigned_accounts,omitempty"`
+	NumRequiredSignatures       uint32                 `protobuf:"varint,3,opt,name=num_required_signatures,json=numRequiredSignatures,proto3" json:"num_required_signatures,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *MessageHeader) Reset() {
+	*x = MessageHeader{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageHeader) ProtoMessage() {}
+
+func (x *MessageHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageHeader.ProtoReflect.Descriptor instead.
+func (*MessageHeader) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MessageHeader) GetNumReadonlySignedAccounts() uint32 {
+	if x != nil {
+		return x.NumReadonlySignedAccounts
+	}
+	return 0
+}
+
+func (x *MessageHeader) GetNumReadonlyUnsignedAccounts() uint32 {
+	if x != nil {
+		return x.NumReadonlyUnsignedAccounts
+	}
+	return 0
+}
+
+func (x *MessageHeader) GetNumRequiredSignatures() uint32 {
+	if x != nil {
+		return x.NumRequiredSignatures
+	}
+	return 0
+}
+
+// An instruction within the transaction.
+type Instruction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Accounts       []uint32               `protobuf:"varint,1,rep,packed,name=accounts,proto3" json:"accounts,omitempty"`
+	Data           string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ProgramIdIndex uint32                 `protobuf:"varint,3,opt,name=program_id_index,json=programIdIndex,proto3" json:"program_id_index,omitempty"`
+	StackHeight    uint32                 `protobuf:"varint,4,opt,name=stack_height,json=stackHeight,proto3" json:"stack_height,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Instruction) Reset() {
+	*x = Instruction{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instruction) ProtoMessage() {}
+
+func (x *Instruction) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instruction.ProtoReflect.Descriptor instead.
+func (*Instruction) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Instruction) GetAccounts() []uint32 {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *Instruction) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *Instruction) GetProgramIdIndex() uint32 {
+	if x != nil {
+		return x.ProgramIdIndex
+	}
+	return 0
+}
+
+func (x *Instruction) GetStackHeight() uint32 {
+	if x != nil {
+		return x.StackHeight
+	}
+	return 0
+}
+
+// Status message for transactions.
+type StatusMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusMessage) Reset() {
+	*x = StatusMessage{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusMessage) ProtoMessage() {}
+
+func (x *StatusMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusMessage.ProtoReflect.Descriptor instead.
+func (*StatusMessage) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StatusMessage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// A large holder of a token.
+type TokenHolder struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	TokenAccount string                 `protobuf:"bytes,1,opt,name=token_account,json=tokenAccount,proto3" json:"token_account,omitempty"`
+	Owner        string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount       float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Share of the total supply, between 0 and 1.
+	Share float64 `protobuf:"fixed64,4,opt,name=share,proto3" json:"share,omitempty"`
+	// Set when the account is a vault of one of the token's pools. Pool vaults
+	// are left out of the concentration figures.
+	LiquidityPool bool `protobuf:"varint,5,opt,name=liquidity_pool,json=liquidityPool,proto3" json:"liquidity_pool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenHolder) Reset() {
+	*x = TokenHolder{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenHolder) ProtoMessage() {}
+
+func (x *TokenHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenHolder.ProtoReflect.Descriptor instead.
+func (*TokenHolder) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TokenHolder) GetTokenAccount() string {
+	if x != nil {
+		return x.TokenAccount
+	}
+	return ""
+}
+
+func (x *TokenHolder) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *TokenHolder) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TokenHolder) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *TokenHolder) GetLiquidityPool() bool {
+	if x != nil {
+		return x.LiquidityPool
+	}
+	return false
+}
+
+// Risk assessment of a token.
+type TokenRiskReport struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Mint         string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	TokenProgram string                 `protobuf:"bytes,2,opt,name=token_program,json=tokenProgram,proto3" json:"token_program,omitempty"`
+	// Empty once the authority has been revoked.
+	MintAuthority   string  `protobuf:"bytes,3,opt,name=mint_authority,json=mintAuthority,proto3" json:"mint_authority,omitempty"`
+	FreezeAuthority string  `protobuf:"bytes,4,opt,name=freeze_authority,json=freezeAuthority,proto3" json:"freeze_authority,omitempty"`
+	Supply          float64 `protobuf:"fixed64,5,opt,name=supply,proto3" json:"supply,omitempty"`
+	Decimals        int32   `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// Token-2022 extensions enabled on the mint.
+	Extensions        []string       `protobuf:"bytes,7,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	PermanentDelegate string         `protobuf:"bytes,8,opt,name=permanent_delegate,json=permanentDelegate,proto3" json:"permanent_delegate,omitempty"`
+	TopHolders        []*TokenHolder `protobuf:"bytes,9,rep,name=top_holders,json=topHolders,proto3" json:"top_holders,omitempty"`
+	// Share of the supply held by the ten largest non-pool holders.
+	Top10Share float64 `protobuf:"fixed64,10,opt,name=top10_share,json=top10Share,proto3" json:"top10_share,omitempty"`
+	// Liquidity across all pools in USD.
+	LiquidityUsd float64 `protobuf:"fixed64,11,opt,name=liquidity_usd,json=liquidityUsd,proto3" json:"liquidity_usd,omitempty"`
+	// The most liquid pool, used for the LP checks.
+	Pool string `protobuf:"bytes,12,opt,name=pool,proto3" json:"pool,omitempty"`
+	// "burned", "partially burned", "not burned", "not applicable" for
+	// concentrated liquidity pools, or "unknown".
+	LpStatus      string  `protobuf:"bytes,13,opt,name=lp_status,json=lpStatus,proto3" json:"lp_status,omitempty"`
+	LpBurnedShare float64 `protobuf:"fixed64,14,opt,name=lp_burned_share,json=lpBurnedShare,proto3" json:"lp_burned_share,omitempty"`
+	// 0 (no findings) to 100 (every check failed).
+	Score int32 `protobuf:"varint,15,opt,name=score,proto3" json:"score,omitempty"`
+	// "low", "medium" or "high".
+	Level         string   `protobuf:"bytes,16,opt,name=level,proto3" json:"level,omitempty"`
+	Reasons       []string `protobuf:"bytes,17,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenRiskReport) Reset() {
+	*x = TokenRiskReport{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenRiskReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenRiskReport) ProtoMessage() {}
+
+func (x *TokenRiskReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenRiskReport.ProtoReflect.Descriptor instead.
+func (*TokenRiskReport) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *TokenRiskReport) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *TokenRiskReport) GetTokenProgram() string {
+	if x != nil {
+		return x.TokenProgram
+	}
+	return ""
+}
+
+func (x *TokenRiskReport) GetMintAuthority() string {
+	if x != nil {
+		return x.MintAuthority
+	}
+	return ""
+}
+
+func (x *TokenRiskReport) GetFreezeAuthority() string {
+	if x != nil {
+		return x.FreezeAuthority
+	}
+	return ""
+}
+
+func (x *TokenRiskReport) GetSupply() float64 {
+	if x != nil {
+		return x.Supply
+	}
+	return 0
+}
+
+func (x *TokenRiskReport) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *TokenRiskReport) GetExtensions() []string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *TokenRiskReport) GetPermanentDelegate() string {
+	if x != nil {
+		return x.PermanentDelegate
+	}
+	return ""
+}
+
+func (x *TokenRiskReport) GetTopHolders() []*TokenHolder {
+	if x != nil {
+		return x.TopHolders
+	}
+	return nil
+}
+
+func (x *TokenRiskReport) GetTop10Share() float64 {
+	if x != nil {
+		return x.Top10Share
+	}
+	return 0
+}
+
+func (x *TokenRiskReport) GetLiquidityUsd() float64 {
+	if x != nil {
+		return x.LiquidityUsd
+	}
+	return 0
+}
+
+func (x *TokenRiskReport) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *TokenRiskReport) GetLpStatus() string {
+	if x != nil {
+		return x.LpStatus
+	}
+	return ""
+}
+
+func (x *TokenRiskReport) GetLpBurnedShare() float64 {
+	if x != nil {
+		return x.LpBurnedShare
+	}
+	return 0
+}
+
+func (x *TokenRiskReport) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TokenRiskReport) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *TokenRiskReport) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// A liquidity position: AMM LP tokens or a concentrated liquidity position NFT.
+type Position struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "raydium_amm", "orca_whirlpool" or "raydium_clmm".
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Pool     string `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	// LP token mint, or the mint of the position NFT.
+	Mint string `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	// Position account of a concentrated liquidity position.
+	Address string           `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Assets  []*PositionAsset `protobuf:"bytes,5,rep,name=assets,proto3" json:"assets,omitempty"`
+	// Share of the pool's reserves held, for AMM LP tokens.
+	Share       float64 `protobuf:"fixed64,6,opt,name=share,proto3" json:"share,omitempty"`
+	TickLower   int32   `protobuf:"varint,7,opt,name=tick_lower,json=tickLower,proto3" json:"tick_lower,omitempty"`
+	TickUpper   int32   `protobuf:"varint,8,opt,name=tick_upper,json=tickUpper,proto3" json:"tick_upper,omitempty"`
+	TickCurrent int32   `protobuf:"varint,9,opt,name=tick_current,json=tickCurrent,proto3" json:"tick_current,omitempty"`
+	InRange     bool    `protobuf:"varint,10,opt,name=in_range,json=inRange,proto3" json:"in_range,omitempty"`
+	// Value of the underlying tokens, excluding fees.
+	Value         float64 `protobuf:"fixed64,11,opt,name=value,proto3" json:"value,omitempty"`
+	FeesValue     float64 `protobuf:"fixed64,12,opt,name=fees_value,json=feesValue,proto3" json:"fees_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Position) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Position) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *Position) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *Position) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Position) GetAssets() []*PositionAsset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *Position) GetShare() float64 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *Position) GetTickLower() int32 {
+	if x != nil {
+		return x.TickLower
+	}
+	return 0
+}
+
+func (x *Position) GetTickUpper() int32 {
+	if x != nil {
+		return x.TickUpper
+	}
+	return 0
+}
+
+func (x *Position) GetTickCurrent() int32 {
+	if x != nil {
+		return x.TickCurrent
+	}
+	return 0
+}
+
+func (x *Position) GetInRange() bool {
+	if x != nil {
+		return x.InRange
+	}
+	return false
+}
+
+func (x *Position) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Position) GetFeesValue() float64 {
+	if x != nil {
+		return x.FeesValue
+	}
+	return 0
+}
+
+// An underlying token of a liquidity position.
+type PositionAsset struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Mint   string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Symbol string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price  float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Value  float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	// Uncollected fees as recorded at the position's last update.
+	FeesOwed      float64 `protobuf:"fixed64,6,opt,name=fees_owed,json=feesOwed,proto3" json:"fees_owed,omitempty"`
+	FeesValue     float64 `protobuf:"fixed64,7,opt,name=fees_value,json=feesValue,proto3" json:"fees_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PositionAsset) Reset() {
+	*x = PositionAsset{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PositionAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PositionAsset) ProtoMessage() {}
+
+func (x *PositionAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PositionAsset.ProtoReflect.Descriptor instead.
+func (*PositionAsset) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *PositionAsset) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *PositionAsset) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *PositionAsset) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PositionAsset) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PositionAsset) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PositionAsset) GetFeesOwed() float64 {
+	if x != nil {
+		return x.FeesOwed
+	}
+	return 0
+}
+
+func (x *PositionAsset) GetFeesValue() float64 {
+	if x != nil {
+		return x.FeesValue
+	}
+	return 0
+}
+
+// An obligation or account in a lending protocol. Values are in USD.
+type LendingPosition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "kamino", "marginfi" or "solend".
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// Obligation or lending account address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Per-asset breakdown; empty for Solend, which only exposes totals.
+	Deposits     []*LendingAsset `protobuf:"bytes,3,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	Borrows      []*LendingAsset `protobuf:"bytes,4,rep,name=borrows,proto3" json:"borrows,omitempty"`
+	DepositValue float64         `protobuf:"fixed64,5,opt,name=deposit_value,json=depositValue,proto3" json:"deposit_value,omitempty"`
+	BorrowValue  float64         `protobuf:"fixed64,6,opt,name=borrow_value,json=borrowValue,proto3" json:"borrow_value,omitempty"`
+	NetValue     float64         `protobuf:"fixed64,7,opt,name=net_value,json=netValue,proto3" json:"net_value,omitempty"`
+	// Liquidation threshold over debt: below 1 the position can be liquidated.
+	// 0 when nothing is borrowed.
+	HealthFactor  float64 `protobuf:"fixed64,8,opt,name=health_factor,json=healthFactor,proto3" json:"health_factor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LendingPosition) Reset() {
+	*x = LendingPosition{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LendingPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LendingPosition) ProtoMessage() {}
+
+func (x *LendingPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LendingPosition.ProtoReflect.Descriptor instead.
+func (*LendingPosition) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LendingPosition) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *LendingPosition) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LendingPosition) GetDeposits() []*LendingAsset {
+	if x != nil {
+		return x.Deposits
+	}
+	return nil
+}
+
+func (x *LendingPosition) GetBorrows() []*LendingAsset {
+	if x != nil {
+		return x.Borrows
+	}
+	return nil
+}
+
+func (x *LendingPosition) GetDepositValue() float64 {
+	if x != nil {
+		return x.DepositValue
+	}
+	return 0
+}
+
+func (x *LendingPosition) GetBorrowValue() float64 {
+	if x != nil {
+		return x.BorrowValue
+	}
+	return 0
+}
+
+func (x *LendingPosition) GetNetValue() float64 {
+	if x != nil {
+		return x.NetValue
+	}
+	return 0
+}
+
+func (x *LendingPosition) GetHealthFactor() float64 {
+	if x != nil {
+		return x.HealthFactor
+	}
+	return 0
+}
+
+// A token deposited into or borrowed from a lending protocol.
+type LendingAsset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mint          string                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Symbol        string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LendingAsset) Reset() {
+	*x = LendingAsset{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LendingAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LendingAsset) ProtoMessage() {}
+
+func (x *LendingAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LendingAsset.ProtoReflect.Descriptor instead.
+func (*LendingAsset) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *LendingAsset) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *LendingAsset) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *LendingAsset) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LendingAsset) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *LendingAsset) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// A saved group of wallets.
+type Portfolio struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name    string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Wallets []*PortfolioWallet     `protobuf:"bytes,3,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	// RFC 3339 creation time.
+	CreatedAt     string `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Portfolio) Reset() {
+	*x = Portfolio{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Portfolio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Portfolio) ProtoMessage() {}
+
+func (x *Portfolio) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Portfolio.ProtoReflect.Descriptor instead.
+func (*Portfolio) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Portfolio) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Portfolio) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Portfolio) GetWallets() []*PortfolioWallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+func (x *Portfolio) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// A wallet in a portfolio.
+type PortfolioWallet struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A base58 address or a .sol domain, stored resolved.
+	Address string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label   string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Tags    []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Owned wallets are the portfolio's own; others are only watched.
+	Owned         bool `protobuf:"varint,4,opt,name=owned,proto3" json:"owned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioWallet) Reset() {
+	*x = PortfolioWallet{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioWallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioWallet) ProtoMessage() {}
+
+func (x *PortfolioWallet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioWallet.ProtoReflect.Descriptor instead.
+func (*PortfolioWallet) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *PortfolioWallet) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PortfolioWallet) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PortfolioWallet) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PortfolioWallet) GetOwned() bool {
+	if x != nil {
+		return x.Owned
+	}
+	return false
+}
+
+type CreatePortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePortfolioRequest) Reset() {
+	*x = CreatePortfolioRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePortfolioRequest) ProtoMessage() {}
+
+func (x *CreatePortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePortfolioRequest.ProtoReflect.Descriptor instead.
+func (*CreatePortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CreatePortfolioRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenamePortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenamePortfolioRequest) Reset() {
+	*x = RenamePortfolioRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenamePortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenamePortfolioRequest) ProtoMessage() {}
+
+func (x *RenamePortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenamePortfolioRequest.ProtoReflect.Descriptor instead.
+func (*RenamePortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *RenamePortfolioRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RenamePortfolioRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PortfolioRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioRequest) Reset() {
+	*x = PortfolioRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortfolioRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortfolioRequest) ProtoMessage() {}
+
+func (x *PortfolioRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortfolioRequest.ProtoReflect.Descriptor instead.
+func (*PortfolioRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PortfolioRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePortfolioResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePortfolioResponse) Reset() {
+	*x = DeletePortfolioResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePortfolioResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePortfolioResponse) ProtoMessage() {}
+
+func (x *DeletePortfolioResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePortfolioResponse.ProtoReflect.Descriptor instead.
+func (*DeletePortfolioResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{68}
+}
+
+type ListPortfoliosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortfoliosRequest) Reset() {
+	*x = ListPortfoliosRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortfoliosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortfoliosRequest) ProtoMessage() {}
+
+func (x *ListPortfoliosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortfoliosRequest.ProtoReflect.Descriptor instead.
+func (*ListPortfoliosRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{69}
+}
+
+type ListPortfoliosResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Portfolios    []*Portfolio           `protobuf:"bytes,1,rep,name=portfolios,proto3" json:"portfolios,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPortfoliosResponse) Reset() {
+	*x = ListPortfoliosResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPortfoliosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPortfoliosResponse) ProtoMessage() {}
+
+func (x *ListPortfoliosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPortfoliosResponse.ProtoReflect.Descriptor instead.
+func (*ListPortfoliosResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListPortfoliosResponse) GetPortfolios() []*Portfolio {
+	if x != nil {
+		return x.Portfolios
+	}
+	return nil
+}
+
+type AddPortfolioWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	Wallet        *PortfolioWallet       `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPortfolioWalletRequest) Reset() {
+	*x = AddPortfolioWalletRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPortfolioWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPortfolioWalletRequest) ProtoMessage() {}
+
+func (x *AddPortfolioWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPortfolioWalletRequest.ProtoReflect.Descriptor instead.
+func (*AddPortfolioWalletRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AddPortfolioWalletRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *AddPortfolioWalletRequest) GetWallet() *PortfolioWallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+type RemovePortfolioWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PortfolioId   int64                  `protobuf:"varint,1,opt,name=portfolio_id,json=portfolioId,proto3" json:"portfolio_id,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemovePortfolioWalletRequest) Reset() {
+	*x = RemovePortfolioWalletRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemovePortfolioWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePortfolioWalletRequest) ProtoMessage() {}
+
+func (x *RemovePortfolioWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePortfolioWalletRequest.ProtoReflect.Descriptor instead.
+func (*RemovePortfolioWalletRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RemovePortfolioWalletRequest) GetPortfolioId() int64 {
+	if x != nil {
+		return x.PortfolioId
+	}
+	return 0
+}
+
+func (x *RemovePortfolioWalletRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// A labelled address.
+type AddressLabel struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label   string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Free-form category such as "cex", "program", "bridge" or "friend".
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// "user" or "known"; ignored when setting a label.
+	Source        string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddressLabel) Reset() {
+	*x = AddressLabel{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddressLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddressLabel) ProtoMessage() {}
+
+func (x *AddressLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddressLabel.ProtoReflect.Descriptor instead.
+func (*AddressLabel) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AddressLabel) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddressLabel) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AddressLabel) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *AddressLabel) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type DeleteAddressLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressLabelRequest) Reset() {
+	*x = DeleteAddressLabelRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressLabelRequest) ProtoMessage() {}
+
+func (x *DeleteAddressLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressLabelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressLabelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteAddressLabelRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type DeleteAddressLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAddressLabelResponse) Reset() {
+	*x = DeleteAddressLabelResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAddressLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressLabelResponse) ProtoMessage() {}
+
+func (x *DeleteAddressLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressLabelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAddressLabelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{75}
+}
+
+type ListAddressLabelsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Also list the configured known entities.
+	IncludeKnown  bool `protobuf:"varint,1,opt,name=include_known,json=includeKnown,proto3" json:"include_known,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressLabelsRequest) Reset() {
+	*x = ListAddressLabelsRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressLabelsRequest) ProtoMessage() {}
+
+func (x *ListAddressLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressLabelsRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListAddressLabelsRequest) GetIncludeKnown() bool {
+	if x != nil {
+		return x.IncludeKnown
+	}
+	return false
+}
+
+type ListAddressLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Labels        []*AddressLabel        `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAddressLabelsResponse) Reset() {
+	*x = ListAddressLabelsResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAddressLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressLabelsResponse) ProtoMessage() {}
+
+func (x *ListAddressLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressLabelsResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListAddressLabelsResponse) GetLabels() []*AddressLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// The treatment of a mint received by a wallet.
+type IncomeOverride struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A base58 address or a .sol domain; empty applies to every wallet.
+	WalletAddress string `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Mint          string `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	// "income", "gift", "spam" or "deposit".
+	Treatment string `protobuf:"bytes,3,opt,name=treatment,proto3" json:"treatment,omitempty"`
+	// RFC 3339 time; ignored when setting an override.
+	UpdatedAt string `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Treats everything received in one transaction instead of a mint. The
+	// wallet and mint must then be empty.
+	Signature     string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncomeOverride) Reset() {
+	*x = IncomeOverride{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncomeOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncomeOverride) ProtoMessage() {}
+
+func (x *IncomeOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncomeOverride.ProtoReflect.Descriptor instead.
+func (*IncomeOverride) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *IncomeOverride) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *IncomeOverride) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *IncomeOverride) GetTreatment() string {
+	if x != nil {
+		return x.Treatment
+	}
+	return ""
+}
+
+func (x *IncomeOverride) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *IncomeOverride) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type DeleteIncomeOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletAddress string                 `protobuf:"bytes,1,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Mint          string                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIncomeOverrideRequest) Reset() {
+	*x = DeleteIncomeOverrideRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIncomeOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIncomeOverrideRequest) ProtoMessage() {}
+
+func (x *DeleteIncomeOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIncomeOverrideRequest.ProtoReflect.Descriptor instead.
+func (*DeleteIncomeOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteIncomeOverrideRequest) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *DeleteIncomeOverrideRequest) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *DeleteIncomeOverrideRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type DeleteIncomeOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteIncomeOverrideResponse) Reset() {
+	*x = DeleteIncomeOverrideResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteIncomeOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteIncomeOverrideResponse) ProtoMessage() {}
+
+func (x *DeleteIncomeOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteIncomeOverrideResponse.ProtoReflect.Descriptor instead.
+func (*DeleteIncomeOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{80}
+}
+
+type ListIncomeOverridesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncomeOverridesRequest) Reset() {
+	*x = ListIncomeOverridesRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomeOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomeOverridesRequest) ProtoMessage() {}
+
+func (x *ListIncomeOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncomeOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListIncomeOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{81}
+}
+
+type ListIncomeOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Overrides     []*IncomeOverride      `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncomeOverridesResponse) Reset() {
+	*x = ListIncomeOverridesResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomeOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomeOverridesResponse) ProtoMessage() {}
+
+func (x *ListIncomeOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncomeOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListIncomeOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListIncomeOverridesResponse) GetOverrides() []*IncomeOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+// A rule that fires an alert when its condition becomes true.
+type AlertRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "price_above", "price_below", "change_24h_above", "wallet_value_below",
+	// "pnl_above" or "pnl_below".
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Token mint, for all kinds but wallet_value_below.
+	Mint string `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	// Wallet address or .sol domain, for wallet value and PnL rules.
+	WalletAddress string `protobuf:"bytes,4,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	// USD for prices, values and PnL; percent for change_24h_above.
+	Threshold float64 `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Optional webhook, posted a JSON payload signed in X-Pulse-Signature.
+	WebhookUrl string `protobuf:"bytes,6,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Optional email recipient.
+	Email string `protobuf:"bytes,7,opt,name=email,proto3" json:"email,omitempty"`
+	// Minimum time between two alerts of the rule.
+	CooldownSeconds int64 `protobuf:"varint,8,opt,name=cooldown_seconds,json=cooldownSeconds,proto3" json:"cooldown_seconds,omitempty"`
+	// Disabled rules are kept but not evaluated.
+	Disabled bool `protobuf:"varint,9,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// RFC 3339 times; last_fired_at is empty until the rule fires.
+	CreatedAt     string `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastFiredAt   string `protobuf:"bytes,11,opt,name=last_fired_at,json=lastFiredAt,proto3" json:"last_fired_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AlertRule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertRule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AlertRule) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *AlertRule) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *AlertRule) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRule) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *AlertRule) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *AlertRule) GetCooldownSeconds() int64 {
+	if x != nil {
+		return x.CooldownSeconds
+	}
+	return 0
+}
+
+func (x *AlertRule) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *AlertRule) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AlertRule) GetLastFiredAt() string {
+	if x != nil {
+		return x.LastFiredAt
+	}
+	return ""
+}
+
+type DeleteAlertRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleRequest) Reset() {
+	*x = DeleteAlertRuleRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleRequest) ProtoMessage() {}
+
+func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteAlertRuleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAlertRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAlertRuleResponse) Reset() {
+	*x = DeleteAlertRuleResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAlertRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAlertRuleResponse) ProtoMessage() {}
+
+func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{85}
+}
+
+type ListAlertRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesRequest) Reset() {
+	*x = ListAlertRulesRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesRequest) ProtoMessage() {}
+
+func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{86}
+}
+
+type ListAlertRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*AlertRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAlertRulesResponse) Reset() {
+	*x = ListAlertRulesResponse{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAlertRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAlertRulesResponse) ProtoMessage() {}
+
+func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListAlertRulesResponse) GetRules() []*AlertRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type WatchAlertsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only stream alerts of these rules; all alerts and transfer
+	// notifications when empty.
+	RuleIds       []int64 `protobuf:"varint,1,rep,packed,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchAlertsRequest) Reset() {
+	*x = WatchAlertsRequest{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchAlertsRequest) ProtoMessage() {}
+
+func (x *WatchAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchAlertsRequest.ProtoReflect.Descriptor instead.
+func (*WatchAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *WatchAlertsRequest) GetRuleIds() []int64 {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+// A fired alert.
+type AlertEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Mint          string                 `protobuf:"bytes,3,opt,name=mint,proto3" json:"mint,omitempty"`
+	WalletAddress string                 `protobuf:"bytes,4,opt,name=wallet_address,json=walletAddress,proto3" json:"wallet_address,omitempty"`
+	Threshold     float64                `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// The observed value that triggered the rule.
+	Value   float64 `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Message string  `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	// RFC 3339 time.
+	FiredAt string `protobuf:"bytes,8,opt,name=fired_at,json=firedAt,proto3" json:"fired_at,omitempty"`
+	// Transfer notifications (kind transfer_in or transfer_out) have no rule
+	// and set these; value is then the transfer's USD value and threshold the
+	// minimum value notified.
+	Signature     string  `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	Amount        float64 `protobuf:"fixed64,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	Symbol        string  `protobuf:"bytes,11,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Counterparty  string  `protobuf:"bytes,12,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlertEvent) Reset() {
+	*x = AlertEvent{}
+	mi := &file_proto_solana_wallet_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertEvent) ProtoMessage() {}
+
+func (x *AlertEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_solana_wallet_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertEvent.ProtoReflect.Descriptor instead.
+func (*AlertEvent) Descriptor() ([]byte, []int) {
+	return file_proto_solana_wallet_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AlertEvent) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetMint() string {
+	if x != nil {
+		return x.Mint
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetWalletAddress() string {
+	if x != nil {
+		return x.WalletAddress
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetFiredAt() string {
+	if x != nil {
+		return x.FiredAt
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AlertEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *AlertEvent) GetCounterparty() string {
+	if x != nil {
+		return x.Counterparty
+	}
+	return ""
+}
+
+var File_proto_solana_wallet_proto protoreflect.FileDescriptor
+
+var file_proto_solana_wallet_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x6f, 0x6c, 0x61, 0x6e, 0x61, 0x5f, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x22, 0x91, 0x02, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x70, 0x61, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x69, 0x64, 0x65, 0x53, 0x70, 0x61, 0x6d, 0x12, 0x22, 0x0a,
+	0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x73,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69,
+	0x70, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x26, 0x0a, 0x10, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x22,
+	0xbd, 0x02, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x73, 0x70,
+	0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x68, 0x69, 0x64, 0x65, 0x53, 0x70,
+	0x61, 0x6d, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x75, 0x73, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x55, 0x73, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22,
+	0xbb, 0x01, 0x0a, 0x17, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6e, 0x0a,
+	0x0a, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6b, 0x0a,
+	0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x2a,
+	0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x77, 0x0a, 0x18, 0x50, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x31, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x10, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x09, 0x54, 0x61, 0x78,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73,
+	0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0xdb,
+	0x01, 0x0a, 0x13, 0x43, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f, 0x79, 0x65, 0x61, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x79, 0x65, 0x61, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6c, 0x6f, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d,
+	0x44, 0x61, 0x79, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xd9, 0x02, 0x0a,
+	0x08, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x62,
+	0x61, 0x73, 0x69, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x04, 0x67, 0x61, 0x69, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x6f, 0x6e,
+	0x67, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x62, 0x61,
+	0x73, 0x69, 0x73, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xce, 0x03, 0x0a,
+	0x12, 0x43, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x78, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x61, 0x78, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x64,
+	0x69, 0x73, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x69, 0x6e, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x62, 0x61, 0x73, 0x69, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f,
+	0x74, 0x65, 0x72, 0x6d, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x54, 0x65, 0x72, 0x6d, 0x47, 0x61, 0x69, 0x6e, 0x12, 0x24,
+	0x0a, 0x0e, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x67, 0x61, 0x69, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d,
+	0x47, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x22, 0xb4, 0x01,
+	0x0a, 0x10, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2f, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x73, 0x6f, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x75, 0x73, 0x64, 0x22, 0xd2, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x6d, 0x65, 0x61, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x39, 0x30, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x70, 0x39, 0x30,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x65, 0x61,
+	0x6e, 0x55, 0x6e, 0x69, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xea, 0x02, 0x0a, 0x09, 0x46,
+	0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x13,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x22, 0x54, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x29, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0xb3, 0x01,
+	0x0a, 0x0a, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x46, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65,
+	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12,
+	0x2b, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x09, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x2c, 0x0a, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x46, 0x65, 0x65, 0x73, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x10, 0x46, 0x6c,
+	0x6f, 0x77, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x6d, 0x69, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x73, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x08, 0x46, 0x6c, 0x6f, 0x77, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x9e, 0x01, 0x0a, 0x08, 0x46, 0x6c, 0x6f,
+	0x77, 0x45, 0x64, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x09, 0x46, 0x6c,
+	0x6f, 0x77, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x26, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x46, 0x6c, 0x6f, 0x77, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x45, 0x64, 0x67, 0x65,
+	0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x12,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64,
+	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
+	0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x62, 0x61, 0x73, 0x69, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x69, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x04, 0x67, 0x61, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c,
+	0x64, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x68,
+	0x6f, 0x6c, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x08, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x6e, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x65, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61,
+	0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x50, 0x6e, 0x6c, 0x22, 0x4d, 0x0a, 0x09,
+	0x44, 0x65, 0x78, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x60, 0x0a, 0x0c, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65,
+	0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x90, 0x04,
+	0x0a, 0x0b, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x72, 0x61, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77,
+	0x69, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6c, 0x6f, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x68, 0x6f,
+	0x6c, 0x64, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x6e, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x6c, 0x69, 0x73, 0x65, 0x64,
+	0x50, 0x6e, 0x6c, 0x12, 0x32, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x64,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x09, 0x62, 0x65,
+	0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x73, 0x74,
+	0x5f, 0x74, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6e, 0x6c, 0x52,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x44, 0x65, 0x78, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x05, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x12, 0x30, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0x44, 0x0a, 0x13, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x07, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x22, 0xc7, 0x08, 0x0a, 0x0e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x6f, 0x6c, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x73, 0x70, 0x61, 0x6d, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0a, 0x73, 0x70, 0x61, 0x6d, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4e, 0x66, 0x74,
+	0x52, 0x04, 0x6e, 0x66, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x6e, 0x66, 0x74, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4e, 0x66, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6e, 0x66, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x66, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6e, 0x66, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x3b, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x6f, 0x6c, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x53, 0x6f, 0x6c, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x61,
+	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x44, 0x0a, 0x11, 0x6c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x6c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x6c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x69, 0x6e, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x06, 0x69, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x13, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xd4, 0x05, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6e, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x70, 0x6e, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x39, 0x0a, 0x0e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x61, 0x72, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x53, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74,
+	0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x29, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x61, 0x6d, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x70, 0x61, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70,
+	0x61, 0x6d, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x73, 0x70, 0x61, 0x6d, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a,
+	0x0c, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x0b, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x71, 0x75, 0x69, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x71, 0x75,
+	0x69, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x0b, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x5f,
+	0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x70, 0x79, 0x22, 0xbc, 0x01, 0x0a,
+	0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x49, 0x6e, 0x55, 0x73,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x55, 0x73, 0x64, 0x22, 0xac, 0x02, 0x0a, 0x03,
+	0x4e, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0d, 0x4e,
+	0x66, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x6b,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x65, 0x72,
+	0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x6b, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x77,
+	0x61, 0x72, 0x64, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0a,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x67, 0x68,
+	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6c,
+	0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x22, 0x8e, 0x03, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6a, 0x73, 0x6f, 0x6e, 0x72, 0x70, 0x63, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1f, 0x0a,
+	0x03, 0x65, 0x72, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x03, 0x65, 0x72, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c,
+	0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x52, 0x0e, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x25, 0x0a,
+	0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x22, 0xa8, 0x01, 0x0a, 0x11, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x95, 0x01, 0x0a,
+	0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x22, 0x35, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x11,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65,
+	0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xde, 0x03, 0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x65, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x66,
+	0x65, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x67, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x6f, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x13, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x12,
+	0x70, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x10,
+	0x70, 0x72, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x28, 0x0a, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x07, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x61, 0x0a, 0x10, 0x49, 0x6e, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x0c,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x75, 0x69, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x0d, 0x75, 0x69, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x88, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x64, 0x65, 0x63,
+	0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x69, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x75, 0x69, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x75, 0x69, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x69,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x1c, 0x0a, 0x06,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x4b, 0x0a, 0x06, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x25, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x67, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x22, 0x9a, 0x02, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x4e, 0x0a, 0x15, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x13, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x68, 0x61, 0x73, 0x68, 0x22, 0x8b, 0x01,
+	0x0a, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c,
+	0x79, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x0f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0f, 0x77, 0x72, 0x69, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0xcd, 0x01, 0x0a, 0x0d,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x1c, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x19, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x43,
+	0x0a, 0x1e, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x75,
+	0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x6f,
+	0x6e, 0x6c, 0x79, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0b,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x28, 0x0a, 0x10, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x64,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x27, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f,
+	0x6c, 0x22, 0xba, 0x04, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x69, 0x73, 0x6b, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x25,
+	0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e,
+	0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x70, 0x65, 0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x74,
+	0x6f, 0x70, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x70,
+	0x31, 0x30, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x74, 0x6f, 0x70, 0x31, 0x30, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x55, 0x73, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x6f, 0x6f, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x70, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6c, 0x70, 0x42, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0xde,
+	0x02, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d,
+	0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x73, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x69, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x55, 0x70, 0x70, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x69, 0x63, 0x6b, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x74, 0x69, 0x63, 0x6b, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xbb, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x66, 0x65, 0x65, 0x73, 0x4f, 0x77, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x66, 0x65, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb3, 0x02,
+	0x0a, 0x0f, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2e, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52,
+	0x08, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x62, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x52, 0x07, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0c, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x22, 0x7e, 0x0a, 0x0c, 0x4c, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6b, 0x0a, 0x0f, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x64, 0x22, 0x2c, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x22, 0x0a, 0x10, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x17, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x22, 0x6f, 0x0a, 0x19, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x06,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x5b, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x6f,
+	0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x72, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x35, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1c,
+	0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x0a, 0x18,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x22, 0x49, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x65, 0x61, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x65, 0x61, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x76, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64,
+	0x65, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x22, 0xc9, 0x02, 0x0a,
+	0x09, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6f, 0x6c, 0x64, 0x6f,
+	0x77, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x66, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x46, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x28, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x41, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x12, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x0a, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x75, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x66, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72, 0x74, 0x79, 0x32, 0x85, 0x05, 0x0a,
 	0x0d, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3c,
 	0x0a, 0x09, 0x41, 0x64, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x77, 0x61,
 	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
@@ -1655,7 +8160,125 @@ var file_proto_solana_wallet_proto_rawDesc = string([]byte{
 	0x12, 0x1a, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57,
 	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x77,
 	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x65,
+	0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x41, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x18, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x1f, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x6c, 0x69, 0x6f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x61, 0x78,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x54, 0x61, 0x78, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x54, 0x61, 0x78, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x69, 0x74, 0x61,
+	0x6c, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x43, 0x61, 0x70, 0x69, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x43, 0x61, 0x70,
+	0x69, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x18, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x18, 0x2e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x47, 0x72, 0x61, 0x70, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x46, 0x6c, 0x6f, 0x77, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x49, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0x98, 0x04, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x6c, 0x69,
+	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x44, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x1e, 0x2e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12,
+	0x44, 0x0a, 0x0f, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c,
+	0x69, 0x6f, 0x12, 0x1e, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x4c, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x18, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f,
+	0x6c, 0x69, 0x6f, 0x12, 0x18, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x73, 0x12, 0x1d, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4a, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69,
+	0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x21, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x12, 0x50, 0x0a,
+	0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x24, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x77,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x6f, 0x6c, 0x69, 0x6f, 0x32,
+	0x8a, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x6f, 0x6f, 0x6b, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x1a,
+	0x14, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x5b, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x21, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x97, 0x02, 0x0a,
+	0x0d, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43,
+	0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x1a, 0x16, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x12, 0x61, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x23, 0x2e, 0x77, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x12, 0x22, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x63, 0x6f, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xad, 0x02, 0x0a, 0x0c, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x11, 0x2e, 0x77, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x11, 0x2e,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x52, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x12, 0x2e, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x65,
 	0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
@@ -1671,57 +8294,223 @@ func file_proto_solana_wallet_proto_rawDescGZIP() []byte {
 	return file_proto_solana_wallet_proto_rawDescData
 }
 
-var file_proto_solana_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_proto_solana_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_proto_solana_wallet_proto_goTypes = []any{
-	(*WalletRequest)(nil),      // 0: wallet.WalletRequest
-	(*MultiWalletRequest)(nil), // 1: wallet.MultiWalletRequest
-	(*WalletResponse)(nil),     // 2: wallet.WalletResponse
-	(*Token)(nil),              // 3: wallet.Token
-	(*PricePoint)(nil),         // 4: wallet.PricePoint
-	(*Transaction)(nil),        // 5: wallet.Transaction
-	(*Error)(nil),              // 6: wallet.Error
-	(*TransactionResult)(nil),  // 7: wallet.TransactionResult
-	(*Meta)(nil),               // 8: wallet.Meta
-	(*InnerInstruction)(nil),   // 9: wallet.InnerInstruction
-	(*TokenBalance)(nil),       // 10: wallet.TokenBalance
-	(*TokenAmount)(nil),        // 11: wallet.TokenAmount
-	(*Reward)(nil),             // 12: wallet.Reward
-	(*Status)(nil),             // 13: wallet.Status
-	(*TransactionData)(nil),    // 14: wallet.TransactionData
-	(*TransactionMessage)(nil), // 15: wallet.TransactionMessage
-	(*AddressTableLookup)(nil), // 16: wallet.AddressTableLookup
-	(*MessageHeader)(nil),      // 17: wallet.MessageHeader
-	(*Instruction)(nil),        // 18: wallet.Instruction
-	(*StatusMessage)(nil),      // 19: wallet.StatusMessage
+	(*WalletRequest)(nil),                // 0: wallet.WalletRequest
+	(*TokenRiskRequest)(nil),             // 1: wallet.TokenRiskRequest
+	(*MultiWalletRequest)(nil),           // 2: wallet.MultiWalletRequest
+	(*PortfolioHistoryRequest)(nil),      // 3: wallet.PortfolioHistoryRequest
+	(*ValuePoint)(nil),                   // 4: wallet.ValuePoint
+	(*TokenValueHistory)(nil),            // 5: wallet.TokenValueHistory
+	(*PortfolioHistoryResponse)(nil),     // 6: wallet.PortfolioHistoryResponse
+	(*TaxReportRequest)(nil),             // 7: wallet.TaxReportRequest
+	(*TaxReport)(nil),                    // 8: wallet.TaxReport
+	(*CapitalGainsRequest)(nil),          // 9: wallet.CapitalGainsRequest
+	(*Disposal)(nil),                     // 10: wallet.Disposal
+	(*IncomeItem)(nil),                   // 11: wallet.IncomeItem
+	(*CapitalGainsReport)(nil),           // 12: wallet.CapitalGainsReport
+	(*FeeReportRequest)(nil),             // 13: wallet.FeeReportRequest
+	(*FeeAmount)(nil),                    // 14: wallet.FeeAmount
+	(*ComputeUsage)(nil),                 // 15: wallet.ComputeUsage
+	(*FeeTotals)(nil),                    // 16: wallet.FeeTotals
+	(*FeeBucket)(nil),                    // 17: wallet.FeeBucket
+	(*WalletFees)(nil),                   // 18: wallet.WalletFees
+	(*FeeReport)(nil),                    // 19: wallet.FeeReport
+	(*FlowGraphRequest)(nil),             // 20: wallet.FlowGraphRequest
+	(*FlowNode)(nil),                     // 21: wallet.FlowNode
+	(*FlowEdge)(nil),                     // 22: wallet.FlowEdge
+	(*FlowGraph)(nil),                    // 23: wallet.FlowGraph
+	(*WalletStatsRequest)(nil),           // 24: wallet.WalletStatsRequest
+	(*TradeResult)(nil),                  // 25: wallet.TradeResult
+	(*TokenPnl)(nil),                     // 26: wallet.TokenPnl
+	(*DexVolume)(nil),                    // 27: wallet.DexVolume
+	(*ActivityCell)(nil),                 // 28: wallet.ActivityCell
+	(*WalletStats)(nil),                  // 29: wallet.WalletStats
+	(*WalletStatsResponse)(nil),          // 30: wallet.WalletStatsResponse
+	(*WalletResponse)(nil),               // 31: wallet.WalletResponse
+	(*Token)(nil),                        // 32: wallet.Token
+	(*LiquidStake)(nil),                  // 33: wallet.LiquidStake
+	(*TokenPool)(nil),                    // 34: wallet.TokenPool
+	(*Nft)(nil),                          // 35: wallet.Nft
+	(*NftCollection)(nil),                // 36: wallet.NftCollection
+	(*StakeAccount)(nil),                 // 37: wallet.StakeAccount
+	(*StakeReward)(nil),                  // 38: wallet.StakeReward
+	(*PricePoint)(nil),                   // 39: wallet.PricePoint
+	(*Transaction)(nil),                  // 40: wallet.Transaction
+	(*FailedTransaction)(nil),            // 41: wallet.FailedTransaction
+	(*Counterparty)(nil),                 // 42: wallet.Counterparty
+	(*Error)(nil),                        // 43: wallet.Error
+	(*TransactionResult)(nil),            // 44: wallet.TransactionResult
+	(*Meta)(nil),                         // 45: wallet.Meta
+	(*InnerInstruction)(nil),             // 46: wallet.InnerInstruction
+	(*TokenBalance)(nil),                 // 47: wallet.TokenBalance
+	(*TokenAmount)(nil),                  // 48: wallet.TokenAmount
+	(*Reward)(nil),                       // 49: wallet.Reward
+	(*Status)(nil),                       // 50: wallet.Status
+	(*TransactionData)(nil),              // 51: wallet.TransactionData
+	(*TransactionMessage)(nil),           // 52: wallet.TransactionMessage
+	(*AddressTableLookup)(nil),           // 53: wallet.AddressTableLookup
+	(*MessageHeader)(nil),                // 54: wallet.MessageHeader
+	(*Instruction)(nil),                  // 55: wallet.Instruction
+	(*StatusMessage)(nil),                // 56: wallet.StatusMessage
+	(*TokenHolder)(nil),                  // 57: wallet.TokenHolder
+	(*TokenRiskReport)(nil),              // 58: wallet.TokenRiskReport
+	(*Position)(nil),                     // 59: wallet.Position
+	(*PositionAsset)(nil),                // 60: wallet.PositionAsset
+	(*LendingPosition)(nil),              // 61: wallet.LendingPosition
+	(*LendingAsset)(nil),                 // 62: wallet.LendingAsset
+	(*Portfolio)(nil),                    // 63: wallet.Portfolio
+	(*PortfolioWallet)(nil),              // 64: wallet.PortfolioWallet
+	(*CreatePortfolioRequest)(nil),       // 65: wallet.CreatePortfolioRequest
+	(*RenamePortfolioRequest)(nil),       // 66: wallet.RenamePortfolioRequest
+	(*PortfolioRequest)(nil),             // 67: wallet.PortfolioRequest
+	(*DeletePortfolioResponse)(nil),      // 68: wallet.DeletePortfolioResponse
+	(*ListPortfoliosRequest)(nil),        // 69: wallet.ListPortfoliosRequest
+	(*ListPortfoliosResponse)(nil),       // 70: wallet.ListPortfoliosResponse
+	(*AddPortfolioWalletRequest)(nil),    // 71: wallet.AddPortfolioWalletRequest
+	(*RemovePortfolioWalletRequest)(nil), // 72: wallet.RemovePortfolioWalletRequest
+	(*AddressLabel)(nil),                 // 73: wallet.AddressLabel
+	(*DeleteAddressLabelRequest)(nil),    // 74: wallet.DeleteAddressLabelRequest
+	(*DeleteAddressLabelResponse)(nil),   // 75: wallet.DeleteAddressLabelResponse
+	(*ListAddressLabelsRequest)(nil),     // 76: wallet.ListAddressLabelsRequest
+	(*ListAddressLabelsResponse)(nil),    // 77: wallet.ListAddressLabelsResponse
+	(*IncomeOverride)(nil),               // 78: wallet.IncomeOverride
+	(*DeleteIncomeOverrideRequest)(nil),  // 79: wallet.DeleteIncomeOverrideRequest
+	(*DeleteIncomeOverrideResponse)(nil), // 80: wallet.DeleteIncomeOverrideResponse
+	(*ListIncomeOverridesRequest)(nil),   // 81: wallet.ListIncomeOverridesRequest
+	(*ListIncomeOverridesResponse)(nil),  // 82: wallet.ListIncomeOverridesResponse
+	(*AlertRule)(nil),                    // 83: wallet.AlertRule
+	(*DeleteAlertRuleRequest)(nil),       // 84: wallet.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),      // 85: wallet.DeleteAlertRuleResponse
+	(*ListAlertRulesRequest)(nil),        // 86: wallet.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),       // 87: wallet.ListAlertRulesResponse
+	(*WatchAlertsRequest)(nil),           // 88: wallet.WatchAlertsRequest
+	(*AlertEvent)(nil),                   // 89: wallet.AlertEvent
 }
 var file_proto_solana_wallet_proto_depIdxs = []int32{
-	3,  // 0: wallet.WalletResponse.tokens:type_name -> wallet.Token
-	5,  // 1: wallet.WalletResponse.transactions:type_name -> wallet.Transaction
-	4,  // 2: wallet.Token.history_prices:type_name -> wallet.PricePoint
-	7,  // 3: wallet.Transaction.result:type_name -> wallet.TransactionResult
-	6,  // 4: wallet.Transaction.err:type_name -> wallet.Error
-	8,  // 5: wallet.TransactionResult.meta:type_name -> wallet.Meta
-	14, // 6: wallet.TransactionResult.transaction:type_name -> wallet.TransactionData
-	9,  // 7: wallet.Meta.inner_instructions:type_name -> wallet.InnerInstruction
-	10, // 8: wallet.Meta.post_token_balances:type_name -> wallet.TokenBalance
-	10, // 9: wallet.Meta.pre_token_balances:type_name -> wallet.TokenBalance
-	12, // 10: wallet.Meta.rewards:type_name -> wallet.Reward
-	13, // 11: wallet.Meta.status:type_name -> wallet.Status
-	18, // 12: wallet.InnerInstruction.instructions:type_name -> wallet.Instruction
-	11, // 13: wallet.TokenBalance.ui_token_amount:type_name -> wallet.TokenAmount
-	15, // 14: wallet.TransactionData.message:type_name -> wallet.TransactionMessage
-	16, // 15: wallet.TransactionMessage.address_table_lookups:type_name -> wallet.AddressTableLookup
-	17, // 16: wallet.TransactionMessage.header:type_name -> wallet.MessageHeader
-	18, // 17: wallet.TransactionMessage.instructions:type_name -> wallet.Instruction
-	0,  // 18: wallet.WalletService.AddWallet:input_type -> wallet.WalletRequest
-	1,  // 19: wallet.WalletService.AggregateWallets:input_type -> wallet.MultiWalletRequest
-	2,  // 20: wallet.WalletService.AddWallet:output_type -> wallet.WalletResponse
-	2,  // 21: wallet.WalletService.AggregateWallets:output_type -> wallet.WalletResponse
-	20, // [20:22] is the sub-list for method output_type
-	18, // [18:20] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	4,  // 0: wallet.TokenValueHistory.points:type_name -> wallet.ValuePoint
+	4,  // 1: wallet.PortfolioHistoryResponse.total:type_name -> wallet.ValuePoint
+	5,  // 2: wallet.PortfolioHistoryResponse.tokens:type_name -> wallet.TokenValueHistory
+	10, // 3: wallet.CapitalGainsReport.disposals:type_name -> wallet.Disposal
+	11, // 4: wallet.CapitalGainsReport.income:type_name -> wallet.IncomeItem
+	14, // 5: wallet.FeeTotals.base:type_name -> wallet.FeeAmount
+	14, // 6: wallet.FeeTotals.priority:type_name -> wallet.FeeAmount
+	14, // 7: wallet.FeeTotals.tips:type_name -> wallet.FeeAmount
+	14, // 8: wallet.FeeTotals.failed_cost:type_name -> wallet.FeeAmount
+	14, // 9: wallet.FeeTotals.total:type_name -> wallet.FeeAmount
+	15, // 10: wallet.FeeTotals.compute:type_name -> wallet.ComputeUsage
+	16, // 11: wallet.FeeBucket.totals:type_name -> wallet.FeeTotals
+	16, // 12: wallet.WalletFees.totals:type_name -> wallet.FeeTotals
+	17, // 13: wallet.WalletFees.buckets:type_name -> wallet.FeeBucket
+	41, // 14: wallet.WalletFees.failures:type_name -> wallet.FailedTransaction
+	18, // 15: wallet.FeeReport.wallets:type_name -> wallet.WalletFees
+	16, // 16: wallet.FeeReport.totals:type_name -> wallet.FeeTotals
+	21, // 17: wallet.FlowGraph.nodes:type_name -> wallet.FlowNode
+	22, // 18: wallet.FlowGraph.edges:type_name -> wallet.FlowEdge
+	25, // 19: wallet.WalletStats.best_trade:type_name -> wallet.TradeResult
+	25, // 20: wallet.WalletStats.worst_trade:type_name -> wallet.TradeResult
+	26, // 21: wallet.WalletStats.tokens:type_name -> wallet.TokenPnl
+	27, // 22: wallet.WalletStats.dexes:type_name -> wallet.DexVolume
+	28, // 23: wallet.WalletStats.activity:type_name -> wallet.ActivityCell
+	29, // 24: wallet.WalletStatsResponse.wallets:type_name -> wallet.WalletStats
+	32, // 25: wallet.WalletResponse.tokens:type_name -> wallet.Token
+	40, // 26: wallet.WalletResponse.transactions:type_name -> wallet.Transaction
+	32, // 27: wallet.WalletResponse.spam_tokens:type_name -> wallet.Token
+	35, // 28: wallet.WalletResponse.nfts:type_name -> wallet.Nft
+	36, // 29: wallet.WalletResponse.nft_collections:type_name -> wallet.NftCollection
+	37, // 30: wallet.WalletResponse.stake_accounts:type_name -> wallet.StakeAccount
+	59, // 31: wallet.WalletResponse.positions:type_name -> wallet.Position
+	61, // 32: wallet.WalletResponse.lending_positions:type_name -> wallet.LendingPosition
+	11, // 33: wallet.WalletResponse.income:type_name -> wallet.IncomeItem
+	41, // 34: wallet.WalletResponse.failed_transactions:type_name -> wallet.FailedTransaction
+	39, // 35: wallet.Token.history_prices:type_name -> wallet.PricePoint
+	34, // 36: wallet.Token.pools:type_name -> wallet.TokenPool
+	33, // 37: wallet.Token.liquid_stake:type_name -> wallet.LiquidStake
+	38, // 38: wallet.StakeAccount.rewards:type_name -> wallet.StakeReward
+	44, // 39: wallet.Transaction.result:type_name -> wallet.TransactionResult
+	43, // 40: wallet.Transaction.err:type_name -> wallet.Error
+	42, // 41: wallet.Transaction.counterparties:type_name -> wallet.Counterparty
+	42, // 42: wallet.Transaction.programs:type_name -> wallet.Counterparty
+	45, // 43: wallet.TransactionResult.meta:type_name -> wallet.Meta
+	51, // 44: wallet.TransactionResult.transaction:type_name -> wallet.TransactionData
+	46, // 45: wallet.Meta.inner_instructions:type_name -> wallet.InnerInstruction
+	47, // 46: wallet.Meta.post_token_balances:type_name -> wallet.TokenBalance
+	47, // 47: wallet.Meta.pre_token_balances:type_name -> wallet.TokenBalance
+	49, // 48: wallet.Meta.rewards:type_name -> wallet.Reward
+	50, // 49: wallet.Meta.status:type_name -> wallet.Status
+	55, // 50: wallet.InnerInstruction.instructions:type_name -> wallet.Instruction
+	48, // 51: wallet.TokenBalance.ui_token_amount:type_name -> wallet.TokenAmount
+	52, // 52: wallet.TransactionData.message:type_name -> wallet.TransactionMessage
+	53, // 53: wallet.TransactionMessage.address_table_lookups:type_name -> wallet.AddressTableLookup
+	54, // 54: wallet.TransactionMessage.header:type_name -> wallet.MessageHeader
+	55, // 55: wallet.TransactionMessage.instructions:type_name -> wallet.Instruction
+	57, // 56: wallet.TokenRiskReport.top_holders:type_name -> wallet.TokenHolder
+	60, // 57: wallet.Position.assets:type_name -> wallet.PositionAsset
+	62, // 58: wallet.LendingPosition.deposits:type_name -> wallet.LendingAsset
+	62, // 59: wallet.LendingPosition.borrows:type_name -> wallet.LendingAsset
+	64, // 60: wallet.Portfolio.wallets:type_name -> wallet.PortfolioWallet
+	63, // 61: wallet.ListPortfoliosResponse.portfolios:type_name -> wallet.Portfolio
+	64, // 62: wallet.AddPortfolioWalletRequest.wallet:type_name -> wallet.PortfolioWallet
+	73, // 63: wallet.ListAddressLabelsResponse.labels:type_name -> wallet.AddressLabel
+	78, // 64: wallet.ListIncomeOverridesResponse.overrides:type_name -> wallet.IncomeOverride
+	83, // 65: wallet.ListAlertRulesResponse.rules:type_name -> wallet.AlertRule
+	0,  // 66: wallet.WalletService.AddWallet:input_type -> wallet.WalletRequest
+	2,  // 67: wallet.WalletService.AggregateWallets:input_type -> wallet.MultiWalletRequest
+	1,  // 68: wallet.WalletService.GetTokenRisk:input_type -> wallet.TokenRiskRequest
+	3,  // 69: wallet.WalletService.GetPortfolioHistory:input_type -> wallet.PortfolioHistoryRequest
+	7,  // 70: wallet.WalletService.ExportTaxReport:input_type -> wallet.TaxReportRequest
+	9,  // 71: wallet.WalletService.GetCapitalGains:input_type -> wallet.CapitalGainsRequest
+	13, // 72: wallet.WalletService.GetFeeReport:input_type -> wallet.FeeReportRequest
+	20, // 73: wallet.WalletService.GetFlowGraph:input_type -> wallet.FlowGraphRequest
+	24, // 74: wallet.WalletService.GetWalletStats:input_type -> wallet.WalletStatsRequest
+	65, // 75: wallet.WatchlistService.CreatePortfolio:input_type -> wallet.CreatePortfolioRequest
+	66, // 76: wallet.WatchlistService.RenamePortfolio:input_type -> wallet.RenamePortfolioRequest
+	67, // 77: wallet.WatchlistService.DeletePortfolio:input_type -> wallet.PortfolioRequest
+	67, // 78: wallet.WatchlistService.GetPortfolio:input_type -> wallet.PortfolioRequest
+	69, // 79: wallet.WatchlistService.ListPortfolios:input_type -> wallet.ListPortfoliosRequest
+	71, // 80: wallet.WatchlistService.AddPortfolioWallet:input_type -> wallet.AddPortfolioWalletRequest
+	72, // 81: wallet.WatchlistService.RemovePortfolioWallet:input_type -> wallet.RemovePortfolioWalletRequest
+	73, // 82: wallet.AddressBookService.SetAddressLabel:input_type -> wallet.AddressLabel
+	74, // 83: wallet.AddressBookService.DeleteAddressLabel:input_type -> wallet.DeleteAddressLabelRequest
+	76, // 84: wallet.AddressBookService.ListAddressLabels:input_type -> wallet.ListAddressLabelsRequest
+	78, // 85: wallet.IncomeService.SetIncomeOverride:input_type -> wallet.IncomeOverride
+	79, // 86: wallet.IncomeService.DeleteIncomeOverride:input_type -> wallet.DeleteIncomeOverrideRequest
+	81, // 87: wallet.IncomeService.ListIncomeOverrides:input_type -> wallet.ListIncomeOverridesRequest
+	83, // 88: wallet.AlertService.CreateAlertRule:input_type -> wallet.AlertRule
+	84, // 89: wallet.AlertService.DeleteAlertRule:input_type -> wallet.DeleteAlertRuleRequest
+	86, // 90: wallet.AlertService.ListAlertRules:input_type -> wallet.ListAlertRulesRequest
+	88, // 91: wallet.AlertService.WatchAlerts:input_type -> wallet.WatchAlertsRequest
+	31, // 92: wallet.WalletService.AddWallet:output_type -> wallet.WalletResponse
+	31, // 93: wallet.WalletService.AggregateWallets:output_type -> wallet.WalletResponse
+	58, // 94: wallet.WalletService.GetTokenRisk:output_type -> wallet.TokenRiskReport
+	6,  // 95: wallet.WalletService.GetPortfolioHistory:output_type -> wallet.PortfolioHistoryResponse
+	8,  // 96: wallet.WalletService.ExportTaxReport:output_type -> wallet.TaxReport
+	12, // 97: wallet.WalletService.GetCapitalGains:output_type -> wallet.CapitalGainsReport
+	19, // 98: wallet.WalletService.GetFeeReport:output_type -> wallet.FeeReport
+	23, // 99: wallet.WalletService.GetFlowGraph:output_type -> wallet.FlowGraph
+	30, // 100: wallet.WalletService.GetWalletStats:output_type -> wallet.WalletStatsResponse
+	63, // 101: wallet.WatchlistService.CreatePortfolio:output_type -> wallet.Portfolio
+	63, // 102: wallet.WatchlistService.RenamePortfolio:output_type -> wallet.Portfolio
+	68, // 103: wallet.WatchlistService.DeletePortfolio:output_type -> wallet.DeletePortfolioResponse
+	63, // 104: wallet.WatchlistService.GetPortfolio:output_type -> wallet.Portfolio
+	70, // 105: wallet.WatchlistService.ListPortfolios:output_type -> wallet.ListPortfoliosResponse
+	63, // 106: wallet.WatchlistService.AddPortfolioWallet:output_type -> wallet.Portfolio
+	63, // 107: wallet.WatchlistService.RemovePortfolioWallet:output_type -> wallet.Portfolio
+	73, // 108: wallet.AddressBookService.SetAddressLabel:output_type -> wallet.AddressLabel
+	75, // 109: wallet.AddressBookService.DeleteAddressLabel:output_type -> wallet.DeleteAddressLabelResponse
+	77, // 110: wallet.AddressBookService.ListAddressLabels:output_type -> wallet.ListAddressLabelsResponse
+	78, // 111: wallet.IncomeService.SetIncomeOverride:output_type -> wallet.IncomeOverride
+	80, // 112: wallet.IncomeService.DeleteIncomeOverride:output_type -> wallet.DeleteIncomeOverrideResponse
+	82, // 113: wallet.IncomeService.ListIncomeOverrides:output_type -> wallet.ListIncomeOverridesResponse
+	83, // 114: wallet.AlertService.CreateAlertRule:output_type -> wallet.AlertRule
+	85, // 115: wallet.AlertService.DeleteAlertRule:output_type -> wallet.DeleteAlertRuleResponse
+	87, // 116: wallet.AlertService.ListAlertRules:output_type -> wallet.ListAlertRulesResponse
+	89, // 117: wallet.AlertService.WatchAlerts:output_type -> wallet.AlertEvent
+	92, // [92:118] is the sub-list for method output_type
+	66, // [66:92] is the sub-list for method input_type
+	66, // [66:66] is the sub-list for extension type_name
+	66, // [66:66] is the sub-list for extension extendee
+	0,  // [0:66] is the sub-list for field type_name
 }
 
 func init() { file_proto_solana_wallet_proto_init() }
@@ -1729,7 +8518,7 @@ func file_proto_solana_wallet_proto_init() {
 	if File_proto_solana_wallet_proto != nil {
 		return
 	}
-	file_proto_solana_wallet_proto_msgTypes[13].OneofWrappers = []any{
+	file_proto_solana_wallet_proto_msgTypes[50].OneofWrappers = []any{
 		(*Status_Ok)(nil),
 		(*Status_ErrorMessage)(nil),
 	}
@@ -1739,9 +8528,9 @@ func file_proto_solana_wallet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_solana_wallet_proto_rawDesc), len(file_proto_solana_wallet_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   90,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   5,
 		},
 		GoTypes:           file_proto_solana_wallet_proto_goTypes,
 		DependencyIndexes: file_proto_solana_wallet_proto_depIdxs,
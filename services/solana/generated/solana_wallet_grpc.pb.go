@@ -19,8 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WalletService_AddWallet_FullMethodName        = "/wallet.WalletService/AddWallet"
-	WalletService_AggregateWallets_FullMethodName = "/wallet.WalletService/AggregateWallets"
+	WalletService_AddWallet_FullMethodName           = "/wallet.WalletService/AddWallet"
+	WalletService_AggregateWallets_FullMethodName    = "/wallet.WalletService/AggregateWallets"
+	WalletService_GetTokenRisk_FullMethodName        = "/wallet.WalletService/GetTokenRisk"
+	WalletService_GetPortfolioHistory_FullMethodName = "/wallet.WalletService/GetPortfolioHistory"
+	WalletService_ExportTaxReport_FullMethodName     = "/wallet.WalletService/ExportTaxReport"
+	WalletService_GetCapitalGains_FullMethodName     = "/wallet.WalletService/GetCapitalGains"
+	WalletService_GetFeeReport_FullMethodName        = "/wallet.WalletService/GetFeeReport"
+	WalletService_GetFlowGraph_FullMethodName        = "/wallet.WalletService/GetFlowGraph"
+	WalletService_GetWalletStats_FullMethodName      = "/wallet.WalletService/GetWalletStats"
 )
 
 // WalletServiceClient is the client API for WalletService service.
@@ -33,6 +40,22 @@ type WalletServiceClient interface {
 	AddWallet(ctx context.Context, in *WalletRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WalletResponse], error)
 	// Streams aggregated WalletResponse messages for multiple wallets.
 	AggregateWallets(ctx context.Context, in *MultiWalletRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WalletResponse], error)
+	// Assesses a token's rug risk from its mint, holders and liquidity.
+	GetTokenRisk(ctx context.Context, in *TokenRiskRequest, opts ...grpc.CallOption) (*TokenRiskReport, error)
+	// Reconstructs the value of wallets over time from their transaction
+	// history, per token and in total.
+	GetPortfolioHistory(ctx context.Context, in *PortfolioHistoryRequest, opts ...grpc.CallOption) (*PortfolioHistoryResponse, error)
+	// Exports classified transactions as CSV for tax software.
+	ExportTaxReport(ctx context.Context, in *TaxReportRequest, opts ...grpc.CallOption) (*TaxReport, error)
+	// Computes a tax year's capital gains, first in first out, and income.
+	GetCapitalGains(ctx context.Context, in *CapitalGainsRequest, opts ...grpc.CallOption) (*CapitalGainsReport, error)
+	// Summarises what wallets paid in base fees, priority fees and Jito tips,
+	// and the compute their transactions used, in total and over time.
+	GetFeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReport, error)
+	// Graphs the funds that flowed between wallets and their counterparties.
+	GetFlowGraph(ctx context.Context, in *FlowGraphRequest, opts ...grpc.CallOption) (*FlowGraph, error)
+	// Scores each wallet's trading from its swaps and cost basis lots.
+	GetWalletStats(ctx context.Context, in *WalletStatsRequest, opts ...grpc.CallOption) (*WalletStatsResponse, error)
 }
 
 type walletServiceClient struct {
@@ -81,6 +104,76 @@ func (c *walletServiceClient) AggregateWallets(ctx context.Context, in *MultiWal
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type WalletService_AggregateWalletsClient = grpc.ServerStreamingClient[WalletResponse]
 
+func (c *walletServiceClient) GetTokenRisk(ctx context.Context, in *TokenRiskRequest, opts ...grpc.CallOption) (*TokenRiskReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenRiskReport)
+	err := c.cc.Invoke(ctx, WalletService_GetTokenRisk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetPortfolioHistory(ctx context.Context, in *PortfolioHistoryRequest, opts ...grpc.CallOption) (*PortfolioHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PortfolioHistoryResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetPortfolioHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ExportTaxReport(ctx context.Context, in *TaxReportRequest, opts ...grpc.CallOption) (*TaxReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaxReport)
+	err := c.cc.Invoke(ctx, WalletService_ExportTaxReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetCapitalGains(ctx context.Context, in *CapitalGainsRequest, opts ...grpc.CallOption) (*CapitalGainsReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CapitalGainsReport)
+	err := c.cc.Invoke(ctx, WalletService_GetCapitalGains_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetFeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeeReport)
+	err := c.cc.Invoke(ctx, WalletService_GetFeeReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetFlowGraph(ctx context.Context, in *FlowGraphRequest, opts ...grpc.CallOption) (*FlowGraph, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlowGraph)
+	err := c.cc.Invoke(ctx, WalletService_GetFlowGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetWalletStats(ctx context.Context, in *WalletStatsRequest, opts ...grpc.CallOption) (*WalletStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WalletStatsResponse)
+	err := c.cc.Invoke(ctx, WalletService_GetWalletStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletServiceServer is the server API for WalletService service.
 // All implementations must embed UnimplementedWalletServiceServer
 // for forward compatibility.
@@ -91,6 +184,22 @@ type WalletServiceServer interface {
 	AddWallet(*WalletRequest, grpc.ServerStreamingServer[WalletResponse]) error
 	// Streams aggregated WalletResponse messages for multiple wallets.
 	AggregateWallets(*MultiWalletRequest, grpc.ServerStreamingServer[WalletResponse]) error
+	// Assesses a token's rug risk from its mint, holders and liquidity.
+	GetTokenRisk(context.Context, *TokenRiskRequest) (*TokenRiskReport, error)
+	// Reconstructs the value of wallets over time from their transaction
+	// history, per token and in total.
+	GetPortfolioHistory(context.Context, *PortfolioHistoryRequest) (*PortfolioHistoryResponse, error)
+	// Exports classified transactions as CSV for tax software.
+	ExportTaxReport(context.Context, *TaxReportRequest) (*TaxReport, error)
+	// Computes a tax year's capital gains, first in first out, and income.
+	GetCapitalGains(context.Context, *CapitalGainsRequest) (*CapitalGainsReport, error)
+	// Summarises what wallets paid in base fees, priority fees and Jito tips,
+	// and the compute their transactions used, in total and over time.
+	GetFeeReport(context.Context, *FeeReportRequest) (*FeeReport, error)
+	// Graphs the funds that flowed between wallets and their counterparties.
+	GetFlowGraph(context.Context, *FlowGraphRequest) (*FlowGraph, error)
+	// Scores each wallet's trading from its swaps and cost basis lots.
+	GetWalletStats(context.Context, *WalletStatsRequest) (*WalletStatsResponse, error)
 	mustEmbedUnimplementedWalletServiceServer()
 }
 
@@ -107,6 +216,27 @@ func (UnimplementedWalletServiceServer) AddWallet(*WalletRequest, grpc.ServerStr
 func (UnimplementedWalletServiceServer) AggregateWallets(*MultiWalletRequest, grpc.ServerStreamingServer[WalletResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method AggregateWallets not implemented")
 }
+func (UnimplementedWalletServiceServer) GetTokenRisk(context.Context, *TokenRiskRequest) (*TokenRiskReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenRisk not implemented")
+}
+func (UnimplementedWalletServiceServer) GetPortfolioHistory(context.Context, *PortfolioHistoryRequest) (*PortfolioHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioHistory not implemented")
+}
+func (UnimplementedWalletServiceServer) ExportTaxReport(context.Context, *TaxReportRequest) (*TaxReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportTaxReport not implemented")
+}
+func (UnimplementedWalletServiceServer) GetCapitalGains(context.Context, *CapitalGainsRequest) (*CapitalGainsReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapitalGains not implemented")
+}
+func (UnimplementedWalletServiceServer) GetFeeReport(context.Context, *FeeReportRequest) (*FeeReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeReport not implemented")
+}
+func (UnimplementedWalletServiceServer) GetFlowGraph(context.Context, *FlowGraphRequest) (*FlowGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlowGraph not implemented")
+}
+func (UnimplementedWalletServiceServer) GetWalletStats(context.Context, *WalletStatsRequest) (*WalletStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletStats not implemented")
+}
 func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
 func (UnimplementedWalletServiceServer) testEmbeddedByValue()                       {}
 
@@ -150,13 +280,168 @@ func _WalletService_AggregateWallets_Handler(srv interface{}, stream grpc.Server
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type WalletService_AggregateWalletsServer = grpc.ServerStreamingServer[WalletResponse]
 
+func _WalletService_GetTokenRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTokenRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetTokenRisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTokenRisk(ctx, req.(*TokenRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetPortfolioHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetPortfolioHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetPortfolioHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetPortfolioHistory(ctx, req.(*PortfolioHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ExportTaxReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaxReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ExportTaxReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_ExportTaxReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ExportTaxReport(ctx, req.(*TaxReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetCapitalGains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapitalGainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetCapitalGains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetCapitalGains_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetCapitalGains(ctx, req.(*CapitalGainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetFeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetFeeReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetFeeReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetFeeReport(ctx, req.(*FeeReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetFlowGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlowGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetFlowGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetFlowGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetFlowGraph(ctx, req.(*FlowGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetWalletStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetWalletStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletService_GetWalletStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetWalletStats(ctx, req.(*WalletStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WalletService_ServiceDesc is the grpc.ServiceDesc for WalletService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var WalletService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "wallet.WalletService",
 	HandlerType: (*WalletServiceServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTokenRisk",
+			Handler:    _WalletService_GetTokenRisk_Handler,
+		},
+		{
+			MethodName: "GetPortfolioHistory",
+			Handler:    _WalletService_GetPortfolioHistory_Handler,
+		},
+		{
+			MethodName: "ExportTaxReport",
+			Handler:    _WalletService_ExportTaxReport_Handler,
+		},
+		{
+			MethodName: "GetCapitalGains",
+			Handler:    _WalletService_GetCapitalGains_Handler,
+		},
+		{
+			MethodName: "GetFeeReport",
+			Handler:    _WalletService_GetFeeReport_Handler,
+		},
+		{
+			MethodName: "GetFlowGraph",
+			Handler:    _WalletService_GetFlowGraph_Handler,
+		},
+		{
+			MethodName: "GetWalletStats",
+			Handler:    _WalletService_GetWalletStats_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "AddWallet",
@@ -171,3 +456,941 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "proto/solana_wallet.proto",
 }
+
+const (
+	WatchlistService_CreatePortfolio_FullMethodName       = "/wallet.WatchlistService/CreatePortfolio"
+	WatchlistService_RenamePortfolio_FullMethodName       = "/wallet.WatchlistService/RenamePortfolio"
+	WatchlistService_DeletePortfolio_FullMethodName       = "/wallet.WatchlistService/DeletePortfolio"
+	WatchlistService_GetPortfolio_FullMethodName          = "/wallet.WatchlistService/GetPortfolio"
+	WatchlistService_ListPortfolios_FullMethodName        = "/wallet.WatchlistService/ListPortfolios"
+	WatchlistService_AddPortfolioWallet_FullMethodName    = "/wallet.WatchlistService/AddPortfolioWallet"
+	WatchlistService_RemovePortfolioWallet_FullMethodName = "/wallet.WatchlistService/RemovePortfolioWallet"
+)
+
+// WatchlistServiceClient is the client API for WatchlistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Manages saved portfolios of owned and watched wallets.
+type WatchlistServiceClient interface {
+	CreatePortfolio(ctx context.Context, in *CreatePortfolioRequest, opts ...grpc.CallOption) (*Portfolio, error)
+	RenamePortfolio(ctx context.Context, in *RenamePortfolioRequest, opts ...grpc.CallOption) (*Portfolio, error)
+	DeletePortfolio(ctx context.Context, in *PortfolioRequest, opts ...grpc.CallOption) (*DeletePortfolioResponse, error)
+	GetPortfolio(ctx context.Context, in *PortfolioRequest, opts ...grpc.CallOption) (*Portfolio, error)
+	ListPortfolios(ctx context.Context, in *ListPortfoliosRequest, opts ...grpc.CallOption) (*ListPortfoliosResponse, error)
+	// Adds a wallet, or updates its label, tags and ownership if already present.
+	AddPortfolioWallet(ctx context.Context, in *AddPortfolioWalletRequest, opts ...grpc.CallOption) (*Portfolio, error)
+	RemovePortfolioWallet(ctx context.Context, in *RemovePortfolioWalletRequest, opts ...grpc.CallOption) (*Portfolio, error)
+}
+
+type watchlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchlistServiceClient(cc grpc.ClientConnInterface) WatchlistServiceClient {
+	return &watchlistServiceClient{cc}
+}
+
+func (c *watchlistServiceClient) CreatePortfolio(ctx context.Context, in *CreatePortfolioRequest, opts ...grpc.CallOption) (*Portfolio, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Portfolio)
+	err := c.cc.Invoke(ctx, WatchlistService_CreatePortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) RenamePortfolio(ctx context.Context, in *RenamePortfolioRequest, opts ...grpc.CallOption) (*Portfolio, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Portfolio)
+	err := c.cc.Invoke(ctx, WatchlistService_RenamePortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) DeletePortfolio(ctx context.Context, in *PortfolioRequest, opts ...grpc.CallOption) (*DeletePortfolioResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePortfolioResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_DeletePortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) GetPortfolio(ctx context.Context, in *PortfolioRequest, opts ...grpc.CallOption) (*Portfolio, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Portfolio)
+	err := c.cc.Invoke(ctx, WatchlistService_GetPortfolio_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) ListPortfolios(ctx context.Context, in *ListPortfoliosRequest, opts ...grpc.CallOption) (*ListPortfoliosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPortfoliosResponse)
+	err := c.cc.Invoke(ctx, WatchlistService_ListPortfolios_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) AddPortfolioWallet(ctx context.Context, in *AddPortfolioWalletRequest, opts ...grpc.CallOption) (*Portfolio, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Portfolio)
+	err := c.cc.Invoke(ctx, WatchlistService_AddPortfolioWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchlistServiceClient) RemovePortfolioWallet(ctx context.Context, in *RemovePortfolioWalletRequest, opts ...grpc.CallOption) (*Portfolio, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Portfolio)
+	err := c.cc.Invoke(ctx, WatchlistService_RemovePortfolioWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WatchlistServiceServer is the server API for WatchlistService service.
+// All implementations must embed UnimplementedWatchlistServiceServer
+// for forward compatibility.
+//
+// Manages saved portfolios of owned and watched wallets.
+type WatchlistServiceServer interface {
+	CreatePortfolio(context.Context, *CreatePortfolioRequest) (*Portfolio, error)
+	RenamePortfolio(context.Context, *RenamePortfolioRequest) (*Portfolio, error)
+	DeletePortfolio(context.Context, *PortfolioRequest) (*DeletePortfolioResponse, error)
+	GetPortfolio(context.Context, *PortfolioRequest) (*Portfolio, error)
+	ListPortfolios(context.Context, *ListPortfoliosRequest) (*ListPortfoliosResponse, error)
+	// Adds a wallet, or updates its label, tags and ownership if already present.
+	AddPortfolioWallet(context.Context, *AddPortfolioWalletRequest) (*Portfolio, error)
+	RemovePortfolioWallet(context.Context, *RemovePortfolioWalletRequest) (*Portfolio, error)
+	mustEmbedUnimplementedWatchlistServiceServer()
+}
+
+// UnimplementedWatchlistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWatchlistServiceServer struct{}
+
+func (UnimplementedWatchlistServiceServer) CreatePortfolio(context.Context, *CreatePortfolioRequest) (*Portfolio, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePortfolio not implemented")
+}
+func (UnimplementedWatchlistServiceServer) RenamePortfolio(context.Context, *RenamePortfolioRequest) (*Portfolio, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePortfolio not implemented")
+}
+func (UnimplementedWatchlistServiceServer) DeletePortfolio(context.Context, *PortfolioRequest) (*DeletePortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePortfolio not implemented")
+}
+func (UnimplementedWatchlistServiceServer) GetPortfolio(context.Context, *PortfolioRequest) (*Portfolio, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (UnimplementedWatchlistServiceServer) ListPortfolios(context.Context, *ListPortfoliosRequest) (*ListPortfoliosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPortfolios not implemented")
+}
+func (UnimplementedWatchlistServiceServer) AddPortfolioWallet(context.Context, *AddPortfolioWalletRequest) (*Portfolio, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioWallet not implemented")
+}
+func (UnimplementedWatchlistServiceServer) RemovePortfolioWallet(context.Context, *RemovePortfolioWalletRequest) (*Portfolio, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioWallet not implemented")
+}
+func (UnimplementedWatchlistServiceServer) mustEmbedUnimplementedWatchlistServiceServer() {}
+func (UnimplementedWatchlistServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeWatchlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WatchlistServiceServer will
+// result in compilation errors.
+type UnsafeWatchlistServiceServer interface {
+	mustEmbedUnimplementedWatchlistServiceServer()
+}
+
+func RegisterWatchlistServiceServer(s grpc.ServiceRegistrar, srv WatchlistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWatchlistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WatchlistService_ServiceDesc, srv)
+}
+
+func _WatchlistService_CreatePortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).CreatePortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_CreatePortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).CreatePortfolio(ctx, req.(*CreatePortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_RenamePortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).RenamePortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_RenamePortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).RenamePortfolio(ctx, req.(*RenamePortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_DeletePortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).DeletePortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_DeletePortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).DeletePortfolio(ctx, req.(*PortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_GetPortfolio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).GetPortfolio(ctx, req.(*PortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_ListPortfolios_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPortfoliosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).ListPortfolios(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_ListPortfolios_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).ListPortfolios(ctx, req.(*ListPortfoliosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_AddPortfolioWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).AddPortfolioWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_AddPortfolioWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).AddPortfolioWallet(ctx, req.(*AddPortfolioWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WatchlistService_RemovePortfolioWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchlistServiceServer).RemovePortfolioWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WatchlistService_RemovePortfolioWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchlistServiceServer).RemovePortfolioWallet(ctx, req.(*RemovePortfolioWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WatchlistService_ServiceDesc is the grpc.ServiceDesc for WatchlistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WatchlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wallet.WatchlistService",
+	HandlerType: (*WatchlistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePortfolio",
+			Handler:    _WatchlistService_CreatePortfolio_Handler,
+		},
+		{
+			MethodName: "RenamePortfolio",
+			Handler:    _WatchlistService_RenamePortfolio_Handler,
+		},
+		{
+			MethodName: "DeletePortfolio",
+			Handler:    _WatchlistService_DeletePortfolio_Handler,
+		},
+		{
+			MethodName: "GetPortfolio",
+			Handler:    _WatchlistService_GetPortfolio_Handler,
+		},
+		{
+			MethodName: "ListPortfolios",
+			Handler:    _WatchlistService_ListPortfolios_Handler,
+		},
+		{
+			MethodName: "AddPortfolioWallet",
+			Handler:    _WatchlistService_AddPortfolioWallet_Handler,
+		},
+		{
+			MethodName: "RemovePortfolioWallet",
+			Handler:    _WatchlistService_RemovePortfolioWallet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/solana_wallet.proto",
+}
+
+const (
+	AddressBookService_SetAddressLabel_FullMethodName    = "/wallet.AddressBookService/SetAddressLabel"
+	AddressBookService_DeleteAddressLabel_FullMethodName = "/wallet.AddressBookService/DeleteAddressLabel"
+	AddressBookService_ListAddressLabels_FullMethodName  = "/wallet.AddressBookService/ListAddressLabels"
+)
+
+// AddressBookServiceClient is the client API for AddressBookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Manages user labels for addresses. Labels, together with the configured
+// known entities, annotate transaction counterparties.
+type AddressBookServiceClient interface {
+	// Labels an address, replacing any previous user label.
+	SetAddressLabel(ctx context.Context, in *AddressLabel, opts ...grpc.CallOption) (*AddressLabel, error)
+	DeleteAddressLabel(ctx context.Context, in *DeleteAddressLabelRequest, opts ...grpc.CallOption) (*DeleteAddressLabelResponse, error)
+	ListAddressLabels(ctx context.Context, in *ListAddressLabelsRequest, opts ...grpc.CallOption) (*ListAddressLabelsResponse, error)
+}
+
+type addressBookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAddressBookServiceClient(cc grpc.ClientConnInterface) AddressBookServiceClient {
+	return &addressBookServiceClient{cc}
+}
+
+func (c *addressBookServiceClient) SetAddressLabel(ctx context.Context, in *AddressLabel, opts ...grpc.CallOption) (*AddressLabel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddressLabel)
+	err := c.cc.Invoke(ctx, AddressBookService_SetAddressLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressBookServiceClient) DeleteAddressLabel(ctx context.Context, in *DeleteAddressLabelRequest, opts ...grpc.CallOption) (*DeleteAddressLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAddressLabelResponse)
+	err := c.cc.Invoke(ctx, AddressBookService_DeleteAddressLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *addressBookServiceClient) ListAddressLabels(ctx context.Context, in *ListAddressLabelsRequest, opts ...grpc.CallOption) (*ListAddressLabelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAddressLabelsResponse)
+	err := c.cc.Invoke(ctx, AddressBookService_ListAddressLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddressBookServiceServer is the server API for AddressBookService service.
+// All implementations must embed UnimplementedAddressBookServiceServer
+// for forward compatibility.
+//
+// Manages user labels for addresses. Labels, together with the configured
+// known entities, annotate transaction counterparties.
+type AddressBookServiceServer interface {
+	// Labels an address, replacing any previous user label.
+	SetAddressLabel(context.Context, *AddressLabel) (*AddressLabel, error)
+	DeleteAddressLabel(context.Context, *DeleteAddressLabelRequest) (*DeleteAddressLabelResponse, error)
+	ListAddressLabels(context.Context, *ListAddressLabelsRequest) (*ListAddressLabelsResponse, error)
+	mustEmbedUnimplementedAddressBookServiceServer()
+}
+
+// UnimplementedAddressBookServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAddressBookServiceServer struct{}
+
+func (UnimplementedAddressBookServiceServer) SetAddressLabel(context.Context, *AddressLabel) (*AddressLabel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressLabel not implemented")
+}
+func (UnimplementedAddressBookServiceServer) DeleteAddressLabel(context.Context, *DeleteAddressLabelRequest) (*DeleteAddressLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddressLabel not implemented")
+}
+func (UnimplementedAddressBookServiceServer) ListAddressLabels(context.Context, *ListAddressLabelsRequest) (*ListAddressLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddressLabels not implemented")
+}
+func (UnimplementedAddressBookServiceServer) mustEmbedUnimplementedAddressBookServiceServer() {}
+func (UnimplementedAddressBookServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeAddressBookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AddressBookServiceServer will
+// result in compilation errors.
+type UnsafeAddressBookServiceServer interface {
+	mustEmbedUnimplementedAddressBookServiceServer()
+}
+
+func RegisterAddressBookServiceServer(s grpc.ServiceRegistrar, srv AddressBookServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAddressBookServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AddressBookService_ServiceDesc, srv)
+}
+
+func _AddressBookService_SetAddressLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressLabel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressBookServiceServer).SetAddressLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressBookService_SetAddressLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressBookServiceServer).SetAddressLabel(ctx, req.(*AddressLabel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressBookService_DeleteAddressLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddressLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressBookServiceServer).DeleteAddressLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressBookService_DeleteAddressLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressBookServiceServer).DeleteAddressLabel(ctx, req.(*DeleteAddressLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AddressBookService_ListAddressLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AddressBookServiceServer).ListAddressLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AddressBookService_ListAddressLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AddressBookServiceServer).ListAddressLabels(ctx, req.(*ListAddressLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AddressBookService_ServiceDesc is the grpc.ServiceDesc for AddressBookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AddressBookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wallet.AddressBookService",
+	HandlerType: (*AddressBookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetAddressLabel",
+			Handler:    _AddressBookService_SetAddressLabel_Handler,
+		},
+		{
+			MethodName: "DeleteAddressLabel",
+			Handler:    _AddressBookService_DeleteAddressLabel_Handler,
+		},
+		{
+			MethodName: "ListAddressLabels",
+			Handler:    _AddressBookService_ListAddressLabels_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/solana_wallet.proto",
+}
+
+const (
+	IncomeService_SetIncomeOverride_FullMethodName    = "/wallet.IncomeService/SetIncomeOverride"
+	IncomeService_DeleteIncomeOverride_FullMethodName = "/wallet.IncomeService/DeleteIncomeOverride"
+	IncomeService_ListIncomeOverrides_FullMethodName  = "/wallet.IncomeService/ListIncomeOverrides"
+)
+
+// IncomeServiceClient is the client API for IncomeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Manages how assets received without giving anything in return are
+// treated: as income, a gift, spam or a plain deposit. Tokens without an
+// override are airdrops, income valued at receipt.
+type IncomeServiceClient interface {
+	// Sets the treatment of a mint, replacing any previous one.
+	SetIncomeOverride(ctx context.Context, in *IncomeOverride, opts ...grpc.CallOption) (*IncomeOverride, error)
+	DeleteIncomeOverride(ctx context.Context, in *DeleteIncomeOverrideRequest, opts ...grpc.CallOption) (*DeleteIncomeOverrideResponse, error)
+	ListIncomeOverrides(ctx context.Context, in *ListIncomeOverridesRequest, opts ...grpc.CallOption) (*ListIncomeOverridesResponse, error)
+}
+
+type incomeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIncomeServiceClient(cc grpc.ClientConnInterface) IncomeServiceClient {
+	return &incomeServiceClient{cc}
+}
+
+func (c *incomeServiceClient) SetIncomeOverride(ctx context.Context, in *IncomeOverride, opts ...grpc.CallOption) (*IncomeOverride, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IncomeOverride)
+	err := c.cc.Invoke(ctx, IncomeService_SetIncomeOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incomeServiceClient) DeleteIncomeOverride(ctx context.Context, in *DeleteIncomeOverrideRequest, opts ...grpc.CallOption) (*DeleteIncomeOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteIncomeOverrideResponse)
+	err := c.cc.Invoke(ctx, IncomeService_DeleteIncomeOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *incomeServiceClient) ListIncomeOverrides(ctx context.Context, in *ListIncomeOverridesRequest, opts ...grpc.CallOption) (*ListIncomeOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncomeOverridesResponse)
+	err := c.cc.Invoke(ctx, IncomeService_ListIncomeOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// IncomeServiceServer is the server API for IncomeService service.
+// All implementations must embed UnimplementedIncomeServiceServer
+// for forward compatibility.
+//
+// Manages how assets received without giving anything in return are
+// treated: as income, a gift, spam or a plain deposit. Tokens without an
+// override are airdrops, income valued at receipt.
+type IncomeServiceServer interface {
+	// Sets the treatment of a mint, replacing any previous one.
+	SetIncomeOverride(context.Context, *IncomeOverride) (*IncomeOverride, error)
+	DeleteIncomeOverride(context.Context, *DeleteIncomeOverrideRequest) (*DeleteIncomeOverrideResponse, error)
+	ListIncomeOverrides(context.Context, *ListIncomeOverridesRequest) (*ListIncomeOverridesResponse, error)
+	mustEmbedUnimplementedIncomeServiceServer()
+}
+
+// UnimplementedIncomeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedIncomeServiceServer struct{}
+
+func (UnimplementedIncomeServiceServer) SetIncomeOverride(context.Context, *IncomeOverride) (*IncomeOverride, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIncomeOverride not implemented")
+}
+func (UnimplementedIncomeServiceServer) DeleteIncomeOverride(context.Context, *DeleteIncomeOverrideRequest) (*DeleteIncomeOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIncomeOverride not implemented")
+}
+func (UnimplementedIncomeServiceServer) ListIncomeOverrides(context.Context, *ListIncomeOverridesRequest) (*ListIncomeOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncomeOverrides not implemented")
+}
+func (UnimplementedIncomeServiceServer) mustEmbedUnimplementedIncomeServiceServer() {}
+func (UnimplementedIncomeServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeIncomeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IncomeServiceServer will
+// result in compilation errors.
+type UnsafeIncomeServiceServer interface {
+	mustEmbedUnimplementedIncomeServiceServer()
+}
+
+func RegisterIncomeServiceServer(s grpc.ServiceRegistrar, srv IncomeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedIncomeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&IncomeService_ServiceDesc, srv)
+}
+
+func _IncomeService_SetIncomeOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncomeOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncomeServiceServer).SetIncomeOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncomeService_SetIncomeOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncomeServiceServer).SetIncomeOverride(ctx, req.(*IncomeOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncomeService_DeleteIncomeOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIncomeOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncomeServiceServer).DeleteIncomeOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncomeService_DeleteIncomeOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncomeServiceServer).DeleteIncomeOverride(ctx, req.(*DeleteIncomeOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IncomeService_ListIncomeOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncomeOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IncomeServiceServer).ListIncomeOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IncomeService_ListIncomeOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IncomeServiceServer).ListIncomeOverrides(ctx, req.(*ListIncomeOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// IncomeService_ServiceDesc is the grpc.ServiceDesc for IncomeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IncomeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wallet.IncomeService",
+	HandlerType: (*IncomeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetIncomeOverride",
+			Handler:    _IncomeService_SetIncomeOverride_Handler,
+		},
+		{
+			MethodName: "DeleteIncomeOverride",
+			Handler:    _IncomeService_DeleteIncomeOverride_Handler,
+		},
+		{
+			MethodName: "ListIncomeOverrides",
+			Handler:    _IncomeService_ListIncomeOverrides_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/solana_wallet.proto",
+}
+
+const (
+	AlertService_CreateAlertRule_FullMethodName = "/wallet.AlertService/CreateAlertRule"
+	AlertService_DeleteAlertRule_FullMethodName = "/wallet.AlertService/DeleteAlertRule"
+	AlertService_ListAlertRules_FullMethodName  = "/wallet.AlertService/ListAlertRules"
+	AlertService_WatchAlerts_FullMethodName     = "/wallet.AlertService/WatchAlerts"
+)
+
+// AlertServiceClient is the client API for AlertService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Manages alert rules, evaluated on a schedule, and streams fired alerts
+// and transfer notifications.
+type AlertServiceClient interface {
+	CreateAlertRule(ctx context.Context, in *AlertRule, opts ...grpc.CallOption) (*AlertRule, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
+	// Streams alerts as they fire, and transfers of watched wallets.
+	WatchAlerts(ctx context.Context, in *WatchAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AlertEvent], error)
+}
+
+type alertServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAlertServiceClient(cc grpc.ClientConnInterface) AlertServiceClient {
+	return &alertServiceClient{cc}
+}
+
+func (c *alertServiceClient) CreateAlertRule(ctx context.Context, in *AlertRule, opts ...grpc.CallOption) (*AlertRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AlertRule)
+	err := c.cc.Invoke(ctx, AlertService_CreateAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleRequest, opts ...grpc.CallOption) (*DeleteAlertRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAlertRuleResponse)
+	err := c.cc.Invoke(ctx, AlertService_DeleteAlertRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAlertRulesResponse)
+	err := c.cc.Invoke(ctx, AlertService_ListAlertRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) WatchAlerts(ctx context.Context, in *WatchAlertsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AlertEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AlertService_ServiceDesc.Streams[0], AlertService_WatchAlerts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAlertsRequest, AlertEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AlertService_WatchAlertsClient = grpc.ServerStreamingClient[AlertEvent]
+
+// AlertServiceServer is the server API for AlertService service.
+// All implementations must embed UnimplementedAlertServiceServer
+// for forward compatibility.
+//
+// Manages alert rules, evaluated on a schedule, and streams fired alerts
+// and transfer notifications.
+type AlertServiceServer interface {
+	CreateAlertRule(context.Context, *AlertRule) (*AlertRule, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error)
+	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
+	// Streams alerts as they fire, and transfers of watched wallets.
+	WatchAlerts(*WatchAlertsRequest, grpc.ServerStreamingServer[AlertEvent]) error
+	mustEmbedUnimplementedAlertServiceServer()
+}
+
+// UnimplementedAlertServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAlertServiceServer struct{}
+
+func (UnimplementedAlertServiceServer) CreateAlertRule(context.Context, *AlertRule) (*AlertRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedAlertServiceServer) DeleteAlertRule(context.Context, *DeleteAlertRuleRequest) (*DeleteAlertRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedAlertServiceServer) ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedAlertServiceServer) WatchAlerts(*WatchAlertsRequest, grpc.ServerStreamingServer[AlertEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAlerts not implemented")
+}
+func (UnimplementedAlertServiceServer) mustEmbedUnimplementedAlertServiceServer() {}
+func (UnimplementedAlertServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAlertServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AlertServiceServer will
+// result in compilation errors.
+type UnsafeAlertServiceServer interface {
+	mustEmbedUnimplementedAlertServiceServer()
+}
+
+func RegisterAlertServiceServer(s grpc.ServiceRegistrar, srv AlertServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAlertServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AlertService_ServiceDesc, srv)
+}
+
+func _AlertService_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlertRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).CreateAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_CreateAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).CreateAlertRule(ctx, req.(*AlertRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).DeleteAlertRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_DeleteAlertRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).ListAlertRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_ListAlertRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).ListAlertRules(ctx, req.(*ListAlertRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_WatchAlerts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAlertsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AlertServiceServer).WatchAlerts(m, &grpc.GenericServerStream[WatchAlertsRequest, AlertEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AlertService_WatchAlertsServer = grpc.ServerStreamingServer[AlertEvent]
+
+// AlertService_ServiceDesc is the grpc.ServiceDesc for AlertService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AlertService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wallet.AlertService",
+	HandlerType: (*AlertServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _AlertService_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _AlertService_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _AlertService_ListAlertRules_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAlerts",
+			Handler:       _AlertService_WatchAlerts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/solana_wallet.proto",
+}
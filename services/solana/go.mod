@@ -4,7 +4,9 @@ go 1.23.6
 
 require (
 	github.com/charmbracelet/log v0.4.0
+	github.com/lib/pq v1.10.9
 	github.com/mr-tron/base58 v1.2.0
+	golang.org/x/image v0.25.0
 	google.golang.org/grpc v1.70.0
 	google.golang.org/protobuf v1.36.5
 )
@@ -22,6 +24,6 @@ require (
 	golang.org/x/exp v0.0.0-20231006140011-7918f672742d // indirect
 	golang.org/x/net v0.32.0 // indirect
 	golang.org/x/sys v0.28.0 // indirect
-	golang.org/x/text v0.21.0 // indirect
+	golang.org/x/text v0.23.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241202173237-19429a94021a // indirect
 )
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	solana_requests "solana/requests/solana"
+)
+
+const (
+	defaultHistoryRange      = "30d"
+	defaultHistoryResolution = "1d"
+	// maxHistoryPoints bounds the series length, and with it the candles
+	// fetched per token.
+	maxHistoryPoints = 1000
+	// dustAmount is treated as zero so float residue from replaying many
+	// changes does not show as a holding.
+	dustAmount = 1e-9
+)
+
+// historyWindow parses a request's history range, defaulting to
+// defaultHistoryRange, and returns the range used and its length. An
+// invalid range is an InvalidArgument status.
+func historyWindow(historyRange string) (string, time.Duration, error) {
+	if historyRange == "" {
+		historyRange = defaultHistoryRange
+	}
+	window, err := parseHistoryRange(historyRange)
+	if err != nil {
+		return "", 0, status.Errorf(codes.InvalidArgument, "invalid history range: %v", err)
+	}
+	return historyRange, window, nil
+}
+
+// balanceChange is a change to a wallet's holding of one asset at a block
+// time. SOL has no mint.
+type balanceChange struct {
+	blockTime int64
+	transfer
+}
+
+// GetPortfolioHistory reconstructs what the wallets held at each point of
+// the range by replaying their balance changes backwards from their current
+// holdings, and values each holding at the token's price at that point.
+// Fees and failed transactions are replayed too since they move balances.
+func (s *server) GetPortfolioHistory(ctx context.Context, req *pb.PortfolioHistoryRequest) (*pb.PortfolioHistoryResponse, error) {
+	wallets, err := s.requestedWallets(ctx, req.WalletAddresses, req.PortfolioId, false)
+	if err != nil {
+		return nil, err
+	}
+	historyRange, window, err := historyWindow(req.HistoryRange)
+	if err != nil {
+		return nil, err
+	}
+	historyResolution := req.HistoryResolution
+	if historyResolution == "" {
+		historyResolution = defaultHistoryResolution
+	}
+	resolution, err := coingecko_requests.ParseResolution(historyResolution)
+	if err != nil || resolution.Timeframe == "minute" {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported history resolution %q", historyResolution)
+	}
+	step := resolution.Seconds()
+	if int64(window.Seconds())/step > maxHistoryPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "%s at %s is more than %d points", historyRange, historyResolution, maxHistoryPoints)
+	}
+	now := time.Now().Unix()
+	start := now - int64(window.Seconds())
+	points := historyTimestamps(start, now, step)
+
+	holdings, err := currentHoldings(wallets)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to fetch holdings: %v", err)
+	}
+	changes, err := historyChanges(ctx, wallets, start)
+	if err != nil {
+		return nil, transactionsError(err)
+	}
+
+	// Walk points newest first, undoing every change made after each.
+	amounts := make(map[string][]float64)
+	next := 0
+	for p := len(points) - 1; p >= 0; p-- {
+		for next < len(changes) && changes[next].blockTime > points[p] {
+			holdings[changes[next].mint] -= changes[next].amount
+			next++
+		}
+		for mint, amount := range holdings {
+			if amount < dustAmount {
+				continue
+			}
+			if amounts[mint] == nil {
+				amounts[mint] = make([]float64, len(points))
+			}
+			amounts[mint][p] = amount
+		}
+	}
+
+	response := &pb.PortfolioHistoryResponse{}
+	totals := make([]float64, len(points))
+	for _, mint := range sortedKeys(amounts) {
+		prices := historyPrices(mint, resolution, start-step, 0)
+		history := &pb.TokenValueHistory{Mint: mint, Symbol: s.historySymbol(mint)}
+		var valued bool
+		for p, timestamp := range points {
+			price := getNearestOHLCVPrice(prices, int32(timestamp))
+			value := amounts[mint][p] * price
+			valued = valued || value > 0
+			totals[p] += value
+			history.Points = append(history.Points, &pb.ValuePoint{
+				Timestamp: int32(timestamp),
+				Amount:    amounts[mint][p],
+				Price:     price,
+				Value:     value,
+			})
+		}
+		// Tokens without a price, such as NFTs and spam, are left out.
+		if valued {
+			response.Tokens = append(response.Tokens, history)
+		}
+	}
+	for p, timestamp := range points {
+		response.Total = append(response.Total, &pb.ValuePoint{Timestamp: int32(timestamp), Value: totals[p]})
+	}
+	return response, nil
+}
+
+// requestedWallets resolves the requested wallets and adds those of the
+// portfolio, only its owned ones when ownedOnly is set.
+func (s *server) requestedWallets(ctx context.Context, inputs []string, portfolioID int64, ownedOnly bool) ([]string, error) {
+	seen := make(map[string]bool)
+	var wallets []string
+	add := func(wallet string) {
+		if !seen[wallet] {
+			seen[wallet] = true
+			wallets = append(wallets, wallet)
+		}
+	}
+	for _, input := range inputs {
+		wallet, err := resolveWalletAddress(input)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid wallet address %s: %v", input, err)
+		}
+		add(wallet)
+	}
+	if portfolioID != 0 {
+		if s.portfolios == nil {
+			return nil, errPortfoliosDisabled
+		}
+		portfolio, err := s.portfolios.Portfolio(ctx, portfolioID)
+		if err != nil {
+			return nil, portfolioError(err)
+		}
+		for _, wallet := range portfolio.Wallets {
+			if wallet.Owned || !ownedOnly {
+				add(wallet.Address)
+			}
+		}
+	}
+	if len(wallets) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no wallets requested")
+	}
+	return wallets, nil
+}
+
+// historyTimestamps returns the step boundaries from start to now, oldest
+// first, followed by now itself.
+func historyTimestamps(start, now, step int64) []int64 {
+	var points []int64
+	for t := start - start%step + step; t < now; t += step {
+		points = append(points, t)
+	}
+	return append(points, now)
+}
+
+// currentHoldings sums the wallets' SOL and fungible token balances, keyed
+// by mint with SOL under the empty mint.
+func currentHoldings(wallets []string) (map[string]float64, error) {
+	holdings := make(map[string]float64)
+	for _, wallet := range wallets {
+		info, err := solana_requests.RequestAccountInfo(wallet)
+		if err != nil {
+			return nil, err
+		}
+		holdings[""] += info.SolAmount
+		accounts, err := solana_requests.RequestTokenAccounts(wallet)
+		if err != nil {
+			return nil, err
+		}
+		fungible, _ := splitNftAccounts(accounts.Result.Value)
+		for _, account := range fungible {
+			info := account.Account.Data.Parsed.Info
+			holdings[info.Mint] += info.TokenAmount.UIAmount
+		}
+	}
+	return holdings, nil
+}
+
+// historyChanges returns the wallets' balance changes since start, newest
+// first. Wallets with more history than can be paged through are replayed
+// only as far back as was fetched.
+func historyChanges(ctx context.Context, wallets []string, start int64) ([]balanceChange, error) {
+	var changes []balanceChange
+	for _, wallet := range wallets {
+		hashes, complete, err := solana_requests.GetTransactionHashesSince(wallet, start)
+		if err != nil {
+			return nil, err
+		}
+		if !complete {
+			log.Warn("transaction history truncated; older holdings are approximate", "wallet", wallet, "signatures", len(hashes))
+		}
+		var signatures []string
+		for _, hash := range hashes {
+			signatures = append(signatures, hash.Signature)
+		}
+		err = fetchTransactions(ctx, signatures, func(tx *pb.Transaction) {
+			for _, change := range balanceChanges(tx, wallet) {
+				changes = append(changes, balanceChange{blockTime: tx.Result.BlockTime, transfer: change})
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].blockTime > changes[j].blockTime
+	})
+	return changes, nil
+}
+
+// historyPrices returns a mint's candles between start and end (0 for now)
+// on its best pool, or none when it has no pool. SOL is priced through
+// wrapped SOL.
+func historyPrices(mint string, resolution coingecko_requests.Resolution, start, end int64) []*pb.PricePoint {
+	if mint == "" {
+		mint = wrappedSolMint
+	}
+	pools, err := coingecko_requests.RankTokenPools(mint)
+	if err != nil || len(pools) == 0 {
+		return nil
+	}
+	candles, err := coingecko_requests.GetOHLCVS(bestPool(pools), resolution, start, end)
+	if err != nil {
+		log.Warn("failed to fetch price history", "mint", mint, "error", err)
+		return nil
+	}
+	return toPricePoints(candles)
+}
+
+// historySymbol names SOL and verified tokens.
+func (s *server) historySymbol(mint string) string {
+	if mint == "" {
+		return "SOL"
+	}
+	if listed, ok := s.verified.Lookup(mint); ok {
+		return listed.Symbol
+	}
+	return ""
+}
+
+// sortedKeys returns the keys of a map in order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
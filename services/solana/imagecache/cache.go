@@ -0,0 +1,251 @@
+// Package imagecache downloads token images, shrinks them to thumbnails and
+// serves them from local disk, so clients never have to load images from
+// slow or unreliable IPFS and Arweave gateways themselves.
+package imagecache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+
+	"solana/safehttp"
+)
+
+const (
+	// ThumbnailSize is the longest edge of a stored thumbnail in pixels.
+	ThumbnailSize = 256
+	// maxImageSize caps how many bytes of a source image we download.
+	maxImageSize = 10 << 20
+	// maxImagePixels rejects images that would decode into huge bitmaps.
+	maxImagePixels = 8000 * 8000
+	// failureTTL is how long a source that failed to download or decode is
+	// not tried again.
+	failureTTL = time.Hour
+	indexFile  = "index.json"
+)
+
+// Cache stores thumbnails on disk named by the sha256 of their PNG encoding
+// and keeps an index from source URL to content hash, so every image is
+// downloaded once and its public URL changes whenever its content does.
+// Sources that fail are remembered in memory for failureTTL, so a dead link
+// is not downloaded again on every request.
+type Cache struct {
+	dir     string
+	baseURL string
+	client  *http.Client
+
+	mu     sync.Mutex
+	index  map[string]string
+	failed map[string]failure
+}
+
+// failure is why a source failed and when it may be tried again.
+type failure struct {
+	err   error
+	retry time.Time
+}
+
+// New opens or creates a cache in dir. Thumbnails are published below
+// baseURL + "/images/".
+func New(dir, baseURL string) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create image cache dir: %w", err)
+	}
+	c := &Cache{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  safehttp.NewClient(20 * time.Second),
+		index:   make(map[string]string),
+		failed:  make(map[string]failure),
+	}
+	data, err := os.ReadFile(filepath.Join(dir, indexFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read image cache index: %w", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &c.index); err != nil {
+			return nil, fmt.Errorf("failed to parse image cache index: %w", err)
+		}
+	}
+	return c, nil
+}
+
+// Fetch returns the public URL of the thumbnail for source, downloading and
+// resizing the image on first use. Only public http(s) sources are fetched.
+func (c *Cache) Fetch(source string) (string, error) {
+	c.mu.Lock()
+	hash, ok := c.index[source]
+	failed, failedBefore := c.failed[source]
+	c.mu.Unlock()
+	if ok {
+		return c.url(hash), nil
+	}
+	if failedBefore && time.Now().Before(failed.retry) {
+		return "", failed.err
+	}
+
+	thumb, err := c.thumbnail(source)
+	if err != nil {
+		now := time.Now()
+		c.mu.Lock()
+		for expired, f := range c.failed {
+			if now.After(f.retry) {
+				delete(c.failed, expired)
+			}
+		}
+		c.failed[source] = failure{err: err, retry: now.Add(failureTTL)}
+		c.mu.Unlock()
+		return "", err
+	}
+	sum := sha256.Sum256(thumb)
+	hash = hex.EncodeToString(sum[:])
+	if err := writeFileAtomic(c.path(hash), thumb); err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.failed, source)
+	c.index[source] = hash
+	index, err := json.Marshal(c.index)
+	if err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(filepath.Join(c.dir, indexFile), index); err != nil {
+		return "", err
+	}
+	return c.url(hash), nil
+}
+
+// ServeHTTP serves stored thumbnails at /images/<hash>.png. Since a hash
+// always names the same bytes, responses may be cached forever.
+func (c *Cache) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name := filepath.Base(r.URL.Path)
+	hash := strings.TrimSuffix(name, ".png")
+	if !isHash(hash) || name != hash+".png" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	w.Header().Set("ETag", `"`+hash+`"`)
+	http.ServeFile(w, r, c.path(hash))
+}
+
+func (c *Cache) url(hash string) string {
+	return c.baseURL + "/images/" + hash + ".png"
+}
+
+func (c *Cache) path(hash string) string {
+	return filepath.Join(c.dir, hash+".png")
+}
+
+// thumbnail downloads source and makes its thumbnail.
+func (c *Cache) thumbnail(source string) ([]byte, error) {
+	if err := safehttp.ValidateURL(source); err != nil {
+		return nil, err
+	}
+	data, err := c.download(source)
+	if err != nil {
+		return nil, err
+	}
+	return thumbnail(data)
+}
+
+func (c *Cache) download(source string) ([]byte, error) {
+	resp, err := c.client.Get(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download image: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("image download returned status %d", resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxImageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image: %w", err)
+	}
+	if len(data) > maxImageSize {
+		return nil, errors.New("image too large")
+	}
+	if contentType := http.DetectContentType(data); !strings.HasPrefix(contentType, "image/") {
+		return nil, fmt.Errorf("unexpected image content type %q", contentType)
+	}
+	return data, nil
+}
+
+// thumbnail decodes a PNG, JPEG, GIF or WebP image, scales it down to fit
+// ThumbnailSize and encodes the result as PNG.
+func thumbnail(data []byte) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("unsupported image: %w", err)
+	}
+	if config.Width*config.Height > maxImagePixels {
+		return nil, fmt.Errorf("image dimensions %dx%d too large", config.Width, config.Height)
+	}
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode image: %w", err)
+	}
+
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width > ThumbnailSize || height > ThumbnailSize {
+		if width >= height {
+			width, height = ThumbnailSize, max(1, height*ThumbnailSize/width)
+		} else {
+			width, height = max(1, width*ThumbnailSize/height), ThumbnailSize
+		}
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), src, bounds, draw.Src, nil)
+
+	var out bytes.Buffer
+	if err := png.Encode(&out, dst); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+	return out.Bytes(), nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func isHash(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/mr-tron/base58"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "solana/generated"
+	"solana/income"
+	"solana/taxreport"
+)
+
+// incomeServer implements the IncomeService. A nil store means no database
+// is configured.
+type incomeServer struct {
+	pb.UnimplementedIncomeServiceServer
+	store *income.Store
+}
+
+var errIncomeDisabled = status.Errorf(codes.FailedPrecondition, "income overrides are not configured")
+
+func (i *incomeServer) SetIncomeOverride(ctx context.Context, req *pb.IncomeOverride) (*pb.IncomeOverride, error) {
+	if i.store == nil {
+		return nil, errIncomeDisabled
+	}
+	wallet, mint, signature, err := overrideKey(req.WalletAddress, req.Mint, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+	treatment := strings.TrimSpace(req.Treatment)
+	if !income.ValidTreatment(treatment) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown treatment %q", req.Treatment)
+	}
+	override, err := i.store.SetOverride(ctx, income.Override{Wallet: wallet, Mint: mint, Signature: signature, Treatment: treatment})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return toPbIncomeOverride(override), nil
+}
+
+func (i *incomeServer) DeleteIncomeOverride(ctx context.Context, req *pb.DeleteIncomeOverrideRequest) (*pb.DeleteIncomeOverrideResponse, error) {
+	if i.store == nil {
+		return nil, errIncomeDisabled
+	}
+	wallet, mint, signature, err := overrideKey(req.WalletAddress, req.Mint, req.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.store.DeleteOverride(ctx, wallet, mint, signature); err != nil {
+		if errors.Is(err, income.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &pb.DeleteIncomeOverrideResponse{}, nil
+}
+
+func (i *incomeServer) ListIncomeOverrides(ctx context.Context, req *pb.ListIncomeOverridesRequest) (*pb.ListIncomeOverridesResponse, error) {
+	overrides, err := i.store.Overrides(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	response := &pb.ListIncomeOverridesResponse{}
+	for _, override := range overrides {
+		response.Overrides = append(response.Overrides, toPbIncomeOverride(override))
+	}
+	return response, nil
+}
+
+// overrideKey validates what an override applies to: a mint, received by
+// one wallet or, without one, by every wallet, or else a transaction.
+func overrideKey(walletInput, mint, signature string) (string, string, string, error) {
+	mint, signature = strings.TrimSpace(mint), strings.TrimSpace(signature)
+	if signature != "" {
+		if strings.TrimSpace(walletInput) != "" || mint != "" {
+			return "", "", "", status.Errorf(codes.InvalidArgument, "a transaction override takes no wallet or mint")
+		}
+		if decoded, err := base58.Decode(signature); err != nil || len(decoded) != 64 {
+			return "", "", "", status.Errorf(codes.InvalidArgument, "invalid signature %q", signature)
+		}
+		return "", "", signature, nil
+	}
+	if err := validateSolanaAddress(mint); err != nil {
+		return "", "", "", status.Errorf(codes.InvalidArgument, "invalid mint: %v", err)
+	}
+	if strings.TrimSpace(walletInput) == "" {
+		return "", mint, "", nil
+	}
+	wallet, err := resolveWalletAddress(walletInput)
+	if err != nil {
+		return "", "", "", status.Errorf(codes.InvalidArgument, "invalid wallet address: %v", err)
+	}
+	return wallet, mint, "", nil
+}
+
+func toPbIncomeOverride(override income.Override) *pb.IncomeOverride {
+	return &pb.IncomeOverride{
+		WalletAddress: override.Wallet,
+		Mint:          override.Mint,
+		Signature:     override.Signature,
+		Treatment:     override.Treatment,
+		UpdatedAt:     override.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// receipt totals what was received of one mint without giving anything in
+// return, and its value at receipt.
+type receipt struct {
+	kind   string
+	amount float64
+	basis  float64
+}
+
+// applyIncome finds the airdrops, income, gifts and spam among a response's
+// transactions, lists the income valued at receipt, and sets the invested
+// amount of tokens received that way to their value at receipt instead of
+// the current price. Holdings beyond what was received keep their invested
+// amount per token.
+func (s *server) applyIncome(ctx context.Context, response *pb.WalletResponse, transactions []*pb.Transaction, owned map[string]bool) {
+	treatments, err := s.income.Treatments(ctx)
+	if err != nil {
+		log.Warn("failed to load income overrides", "error", err)
+	}
+	var all []classifiedTransaction
+	seen := make(map[string]bool)
+	for _, tx := range transactions {
+		signature := transactionSignature(tx)
+		if seen[signature] {
+			continue
+		}
+		seen[signature] = true
+		for _, wallet := range sortedKeys(owned) {
+			all = append(all, classifyTransaction(tx, wallet, owned))
+		}
+	}
+	s.applyTreatments(ctx, all, treatments)
+	var received []classifiedTransaction
+	for _, classified := range all {
+		switch classified.kind {
+		case taxreport.KindAirdrop, taxreport.KindIncome, taxreport.KindGift, taxreport.KindSpam:
+			received = append(received, classified)
+		}
+	}
+	if len(received) == 0 {
+		return
+	}
+	sort.SliceStable(received, func(i, j int) bool {
+		return received[i].blockTime < received[j].blockTime
+	})
+
+	prices := newPriceHistory(received[0].blockTime, time.Now().Unix())
+	receipts := make(map[string]*receipt)
+	for _, classified := range received {
+		for _, t := range classified.received {
+			var value float64
+			if classified.kind != taxreport.KindSpam {
+				value = t.amount * prices.price(t.mint, classified.blockTime)
+			}
+			r, ok := receipts[t.mint]
+			if !ok {
+				r = &receipt{}
+				receipts[t.mint] = r
+			}
+			r.kind = classified.kind
+			r.amount += t.amount
+			r.basis += value
+			if (classified.kind == taxreport.KindAirdrop || classified.kind == taxreport.KindIncome) && value > 0 {
+				response.Income = append(response.Income, &pb.IncomeItem{
+					Kind:       classified.kind,
+					Symbol:     s.assetSymbol(t.mint),
+					Mint:       t.mint,
+					Amount:     t.amount,
+					Value:      value,
+					ReceivedAt: time.Unix(classified.blockTime, 0).UTC().Format(time.RFC3339),
+					Source:     classified.wallet,
+					Signature:  classified.signature,
+				})
+				response.IncomeValue += value
+			}
+		}
+	}
+
+	for _, token := range append(response.Tokens, response.SpamTokens...) {
+		r, ok := receipts[token.Address]
+		if !ok || r.amount <= 0 || token.Amount <= 0 {
+			continue
+		}
+		held := min(token.Amount, r.amount)
+		perToken := token.Invested / token.Amount
+		token.Acquisition = r.kind
+		token.Invested = r.basis*held/r.amount + (token.Amount-held)*perToken
+		token.Pnl = token.Value - token.Invested
+	}
+}
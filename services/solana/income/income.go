@@ -0,0 +1,150 @@
+// Package income stores how users want assets they received without giving
+// anything in return treated: as income, a gift, spam or a plain deposit.
+package income
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNotFound is returned for overrides that do not exist.
+var ErrNotFound = errors.New("income override not found")
+
+// Treatments. Income is valued at receipt and reported as income; a gift
+// is acquired at its value at receipt but is not income; spam has zero
+// value and basis; a deposit is a plain acquisition, such as a withdrawal
+// from the user's own exchange account.
+const (
+	TreatmentIncome  = "income"
+	TreatmentGift    = "gift"
+	TreatmentSpam    = "spam"
+	TreatmentDeposit = "deposit"
+)
+
+// ValidTreatment reports whether treatment is a known treatment.
+func ValidTreatment(treatment string) bool {
+	switch treatment {
+	case TreatmentIncome, TreatmentGift, TreatmentSpam, TreatmentDeposit:
+		return true
+	}
+	return false
+}
+
+// Override sets the treatment of a mint received by a wallet, or by every
+// wallet when Wallet is empty. An override with a Signature instead sets
+// the treatment of everything received in that one transaction; its Wallet
+// and Mint are empty.
+type Override struct {
+	Wallet    string
+	Mint      string
+	Signature string
+	Treatment string
+	UpdatedAt time.Time
+}
+
+// Treatments looks up overrides by wallet, mint and signature. A nil
+// Treatments has none.
+type Treatments map[[3]string]string
+
+// For returns the treatment of a mint received by a wallet in a
+// transaction, preferring an override for the transaction, then one for
+// the wallet, then one for every wallet, or "" without one.
+func (t Treatments) For(signature, wallet, mint string) string {
+	if treatment, ok := t[[3]string{"", "", signature}]; ok && signature != "" {
+		return treatment
+	}
+	if treatment, ok := t[[3]string{wallet, mint, ""}]; ok {
+		return treatment
+	}
+	return t[[3]string{"", mint, ""}]
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS income_overrides (
+	wallet     TEXT NOT NULL DEFAULT '',
+	mint       TEXT NOT NULL DEFAULT '',
+	signature  TEXT NOT NULL DEFAULT '',
+	treatment  TEXT NOT NULL,
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (wallet, mint, signature)
+);`
+
+// Store keeps overrides in Postgres. A nil *Store has no overrides.
+type Store struct {
+	db *sql.DB
+}
+
+// New creates the override table if needed and returns a store using db.
+func New(db *sql.DB) (*Store, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create income override table: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// SetOverride sets the treatment of a wallet's mint or of a transaction,
+// replacing any previous one.
+func (s *Store) SetOverride(ctx context.Context, override Override) (Override, error) {
+	err := s.db.QueryRowContext(ctx, `
+		INSERT INTO income_overrides (wallet, mint, signature, treatment) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (wallet, mint, signature)
+		DO UPDATE SET treatment = EXCLUDED.treatment, updated_at = now()
+		RETURNING updated_at`,
+		override.Wallet, override.Mint, override.Signature, override.Treatment,
+	).Scan(&override.UpdatedAt)
+	if err != nil {
+		return Override{}, fmt.Errorf("failed to set income override: %w", err)
+	}
+	return override, nil
+}
+
+// DeleteOverride removes the override of a wallet's mint or of a
+// transaction.
+func (s *Store) DeleteOverride(ctx context.Context, wallet, mint, signature string) error {
+	result, err := s.db.ExecContext(ctx,
+		`DELETE FROM income_overrides WHERE wallet = $1 AND mint = $2 AND signature = $3`, wallet, mint, signature)
+	if err != nil {
+		return fmt.Errorf("failed to delete income override: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Overrides returns every override, ordered by wallet, mint and signature.
+func (s *Store) Overrides(ctx context.Context) ([]Override, error) {
+	if s == nil {
+		return nil, nil
+	}
+	rows, err := s.db.QueryContext(ctx, `SELECT wallet, mint, signature, treatment, updated_at FROM income_overrides ORDER BY wallet, mint, signature`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read income overrides: %w", err)
+	}
+	defer rows.Close()
+	var overrides []Override
+	for rows.Next() {
+		var override Override
+		if err := rows.Scan(&override.Wallet, &override.Mint, &override.Signature, &override.Treatment, &override.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to read income override: %w", err)
+		}
+		overrides = append(overrides, override)
+	}
+	return overrides, rows.Err()
+}
+
+// Treatments loads every override for lookup.
+func (s *Store) Treatments(ctx context.Context) (Treatments, error) {
+	overrides, err := s.Overrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	treatments := make(Treatments, len(overrides))
+	for _, override := range overrides {
+		treatments[[3]string{override.Wallet, override.Mint, override.Signature}] = override.Treatment
+	}
+	return treatments, nil
+}
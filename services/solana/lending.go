@@ -0,0 +1,211 @@
+package main
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/charmbracelet/log"
+
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	solana_requests "solana/requests/solana"
+)
+
+const (
+	protocolKamino   = "kamino"
+	protocolMarginfi = "marginfi"
+	protocolSolend   = "solend"
+)
+
+// collectLendingPositions finds the owners' Kamino obligations, MarginFi
+// accounts and Solend obligations. A protocol that fails to load is logged
+// and skipped so the others are still reported.
+func (s *server) collectLendingPositions(owners []string) []*pb.LendingPosition {
+	var positions []*pb.LendingPosition
+	for _, owner := range owners {
+		kamino, err := s.kaminoPositions(owner)
+		if err != nil {
+			log.Warn("failed to fetch Kamino obligations", "wallet", owner, "error", err)
+		}
+		marginfi, err := s.marginfiPositions(owner)
+		if err != nil {
+			log.Warn("failed to fetch MarginFi accounts", "wallet", owner, "error", err)
+		}
+		solend, err := solendPositions(owner)
+		if err != nil {
+			log.Warn("failed to fetch Solend obligations", "wallet", owner, "error", err)
+		}
+		positions = append(positions, kamino...)
+		positions = append(positions, marginfi...)
+		positions = append(positions, solend...)
+	}
+	return positions
+}
+
+// kaminoPositions reports Kamino obligations at the market values the
+// program last refreshed them with. Deposits are held as collateral tokens,
+// so their liquidity amount is derived from value and reserve price.
+func (s *server) kaminoPositions(owner string) ([]*pb.LendingPosition, error) {
+	obligations, err := solana_requests.GetKaminoObligations(owner)
+	if err != nil || len(obligations) == 0 {
+		return nil, err
+	}
+	var reserveAddresses []string
+	for _, obligation := range obligations {
+		for _, deposit := range obligation.Deposits {
+			reserveAddresses = append(reserveAddresses, deposit.Reserve)
+		}
+		for _, borrow := range obligation.Borrows {
+			reserveAddresses = append(reserveAddresses, borrow.Reserve)
+		}
+	}
+	reserves, err := solana_requests.GetKaminoReserves(reserveAddresses)
+	if err != nil {
+		return nil, err
+	}
+
+	var positions []*pb.LendingPosition
+	for _, obligation := range obligations {
+		if len(obligation.Deposits) == 0 && len(obligation.Borrows) == 0 {
+			continue
+		}
+		position := &pb.LendingPosition{
+			Protocol:     protocolKamino,
+			Address:      obligation.Address,
+			DepositValue: obligation.DepositedValue,
+			BorrowValue:  obligation.BorrowedValue,
+			NetValue:     obligation.DepositedValue - obligation.BorrowedValue,
+			HealthFactor: healthFactor(obligation.UnhealthyBorrowValue, obligation.BorrowFactorAdjustedDebt),
+		}
+		for _, deposit := range obligation.Deposits {
+			reserve := reserves[deposit.Reserve]
+			asset := s.lendingAsset(reserve.LiquidityMint, 0, reserve.MarketPrice)
+			asset.Value = deposit.MarketValue
+			if asset.Price > 0 {
+				asset.Amount = asset.Value / asset.Price
+			}
+			position.Deposits = append(position.Deposits, asset)
+		}
+		for _, borrow := range obligation.Borrows {
+			reserve := reserves[borrow.Reserve]
+			asset := s.lendingAsset(reserve.LiquidityMint, borrow.Amount/math.Pow10(reserve.Decimals), reserve.MarketPrice)
+			asset.Value = borrow.MarketValue
+			position.Borrows = append(position.Borrows, asset)
+		}
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// marginfiPositions reports MarginFi accounts, converting balance shares
+// into token amounts with each bank's share values and pricing them at
+// current token prices. MarginFi's risk weights live in the bank config, so
+// the health factor here is unweighted: deposit value over borrow value.
+func (s *server) marginfiPositions(owner string) ([]*pb.LendingPosition, error) {
+	accounts, err := solana_requests.GetMarginfiAccounts(owner)
+	if err != nil || len(accounts) == 0 {
+		return nil, err
+	}
+	var bankAddresses []string
+	for _, account := range accounts {
+		for _, balance := range account.Balances {
+			bankAddresses = append(bankAddresses, balance.Bank)
+		}
+	}
+	banks, err := solana_requests.GetMarginfiBanks(bankAddresses)
+	if err != nil {
+		return nil, err
+	}
+	var mints []string
+	for _, bank := range banks {
+		mints = append(mints, bank.Mint)
+	}
+	prices, err := coingecko_requests.GetCoinGeckoTokenPrices(mints)
+	if err != nil {
+		return nil, err
+	}
+
+	var positions []*pb.LendingPosition
+	for _, account := range accounts {
+		if len(account.Balances) == 0 {
+			continue
+		}
+		position := &pb.LendingPosition{Protocol: protocolMarginfi, Address: account.Address}
+		for _, balance := range account.Balances {
+			bank, ok := banks[balance.Bank]
+			if !ok {
+				log.Warn("unknown MarginFi bank", "bank", balance.Bank)
+				continue
+			}
+			price, _ := strconv.ParseFloat(prices[bank.Mint], 64)
+			scale := math.Pow10(bank.Decimals)
+			if deposited := balance.AssetShares * bank.AssetShareValue / scale; deposited > 0 {
+				asset := s.lendingAsset(bank.Mint, deposited, price)
+				position.Deposits = append(position.Deposits, asset)
+				position.DepositValue += asset.Value
+			}
+			if borrowed := balance.LiabilityShares * bank.LiabilityShareValue / scale; borrowed > 0 {
+				asset := s.lendingAsset(bank.Mint, borrowed, price)
+				position.Borrows = append(position.Borrows, asset)
+				position.BorrowValue += asset.Value
+			}
+		}
+		position.NetValue = position.DepositValue - position.BorrowValue
+		position.HealthFactor = healthFactor(position.DepositValue, position.BorrowValue)
+		positions = append(positions, position)
+	}
+	return positions, nil
+}
+
+// solendPositions reports the totals of Solend obligations as of their
+// last refresh.
+func solendPositions(owner string) ([]*pb.LendingPosition, error) {
+	obligations, err := solana_requests.GetSolendObligations(owner)
+	if err != nil {
+		return nil, err
+	}
+	var positions []*pb.LendingPosition
+	for _, obligation := range obligations {
+		if obligation.DepositedValue == 0 && obligation.BorrowedValue == 0 {
+			continue
+		}
+		positions = append(positions, &pb.LendingPosition{
+			Protocol:     protocolSolend,
+			Address:      obligation.Address,
+			DepositValue: obligation.DepositedValue,
+			BorrowValue:  obligation.BorrowedValue,
+			NetValue:     obligation.DepositedValue - obligation.BorrowedValue,
+			HealthFactor: healthFactor(obligation.UnhealthyBorrowValue, obligation.BorrowedValue),
+		})
+	}
+	return positions, nil
+}
+
+// lendingAsset builds a deposited or borrowed asset, naming it from the
+// verified token list.
+func (s *server) lendingAsset(mint string, amount, price float64) *pb.LendingAsset {
+	asset := &pb.LendingAsset{Mint: mint, Amount: amount, Price: price, Value: amount * price}
+	if listed, ok := s.verified.Lookup(mint); ok {
+		asset.Symbol = listed.Symbol
+	}
+	return asset
+}
+
+// healthFactor divides the borrow value at which a position becomes
+// liquidatable by its debt, or returns 0 without debt.
+func healthFactor(liquidationThreshold, debt float64) float64 {
+	if debt <= 0 {
+		return 0
+	}
+	return liquidationThreshold / debt
+}
+
+// applyLendingPositions sets the lending positions of a response and adds
+// their net value to its wallet value, so debt reduces it.
+func applyLendingPositions(response *pb.WalletResponse, positions []*pb.LendingPosition) {
+	response.LendingPositions = positions
+	for _, position := range positions {
+		response.LendingValue += position.NetValue
+	}
+	response.WalletValue += response.LendingValue
+}
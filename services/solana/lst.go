@@ -0,0 +1,168 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	marinade_requests "solana/requests/marinade"
+	solana_requests "solana/requests/solana"
+)
+
+const (
+	wrappedSolMint = "So11111111111111111111111111111111111111112"
+	// epochsPerYear assumes the nominal 432,000 slots of 400ms per epoch.
+	epochsPerYear  = 365.25 * 24 * 60 * 60 / (432000 * 0.4)
+	secondsPerYear = 365.25 * 24 * 60 * 60
+)
+
+// knownStakePools maps liquid staking token mints to their SPL stake pool.
+// mSOL is handled separately since Marinade is not an SPL stake pool.
+var knownStakePools = map[string]string{
+	"J1toso1uCk3RLmjorhTtrVwY9HJ7X8V9yYac6Y7kGCPn": "Jito4APyf642JPZPx3hGc6WWJ8zPKtRbRs4P815Awbb",  // jitoSOL
+	"bSo13r4TkiE4KumL71LsHTPpL2euBYLFx6h9HP3piy1":  "stk9ApL5HeVAwPLr3TLhDXdZS8ptVu7zp6ov8HFDuMi",  // bSOL
+	"jupSoLaHXQiZZTSfEWMTRRgpnyFm8f6sZdosWBjx93v":  "8VpRhuxa7sUUepdY3kQiTmX9rS5vx4WgaXiAnXq4KCtr", // JupSOL
+}
+
+// loadStakePools returns the known stake pools extended by a comma
+// separated list of mint=pool pairs.
+func loadStakePools(spec string) (map[string]string, error) {
+	pools := make(map[string]string, len(knownStakePools))
+	for mint, pool := range knownStakePools {
+		pools[mint] = pool
+	}
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		mint, pool, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid stake pool %q, want mint=pool", pair)
+		}
+		if err := validateSolanaAddress(mint); err != nil {
+			return nil, err
+		}
+		if err := validateSolanaAddress(pool); err != nil {
+			return nil, err
+		}
+		pools[mint] = pool
+	}
+	return pools, nil
+}
+
+// isLiquidStake reports whether a mint is a recognised liquid staking token.
+func (s *server) isLiquidStake(mint string) bool {
+	_, ok := s.stakePools[mint]
+	return ok || mint == marinade_requests.MsolMint
+}
+
+// liquidStake reads the exchange rate of a liquid staking token from its
+// stake pool and estimates the yield from the change since the last epoch.
+func (s *server) liquidStake(mint string) (*pb.LiquidStake, error) {
+	if mint == marinade_requests.MsolMint {
+		rate, err := marinade_requests.GetMsolPrice()
+		if err != nil {
+			return nil, err
+		}
+		apy, err := marinade_requests.GetMsolAPY()
+		if err != nil {
+			log.Warn("failed to fetch mSOL APY", "error", err)
+		}
+		return &pb.LiquidStake{StakePool: "marinade", ExchangeRate: rate, Apy: apy}, nil
+	}
+	address, ok := s.stakePools[mint]
+	if !ok {
+		return nil, fmt.Errorf("%s is not a known liquid staking token", mint)
+	}
+	pool, err := solana_requests.GetStakePool(address)
+	if err != nil {
+		return nil, err
+	}
+	if pool.PoolMint != mint {
+		return nil, fmt.Errorf("stake pool %s mints %s, not %s", address, pool.PoolMint, mint)
+	}
+	stake := &pb.LiquidStake{StakePool: address, ExchangeRate: pool.ExchangeRate()}
+	if last := pool.LastEpochExchangeRate(); last > 0 && stake.ExchangeRate > last {
+		stake.Apy = math.Pow(stake.ExchangeRate/last, epochsPerYear) - 1
+	}
+	return stake, nil
+}
+
+// applyLiquidStake prices a liquid staking token at its exchange rate
+// instead of its often thin DEX pools. Its price history is SOL's, scaled by
+// the exchange rate discounted back at the current yield, so staking yield
+// shows up in the token's PnL rather than being lost in pool noise. The
+// amount held is taken as invested when the first of tokenAccounts first
+// received it, so the PnL includes the exchange rate's growth since.
+func (s *server) applyLiquidStake(token *pb.Token, tokenAccounts []string, opts tokenOptions) error {
+	stake, err := s.liquidStake(token.Address)
+	if err != nil {
+		return err
+	}
+	token.LiquidStake = stake
+	token.Price = stake.ExchangeRate * opts.solanaPrice
+
+	pools, _ := coingecko_requests.RankTokenPools(wrappedSolMint)
+	prices, _ := coingecko_requests.GetOHLCVS(bestPool(pools), opts.resolution, opts.historyStart, 0)
+	points := toPricePoints(prices)
+	now := time.Now().Unix()
+	for _, point := range points {
+		rate := exchangeRateAt(stake, now, int64(point.Timestamp))
+		point.Open *= rate
+		point.High *= rate
+		point.Low *= rate
+		point.Close *= rate
+	}
+	token.HistoryPrices = points
+
+	acquired, err := firstReceipt(tokenAccounts)
+	if err != nil {
+		log.Warn("failed to find when liquid staking token was acquired", "mint", token.Address, "error", err)
+		return nil
+	}
+	solPrice := newPriceHistory(acquired, acquired).price("", acquired)
+	if solPrice > 0 {
+		baseline := solPrice * exchangeRateAt(stake, now, acquired)
+		token.Invested = token.Amount * baseline
+		token.Pnl = token.Amount * (token.Price - baseline)
+	}
+	return nil
+}
+
+// exchangeRateAt estimates a liquid staking token's exchange rate at a unix
+// time by discounting the current rate at the current yield.
+func exchangeRateAt(stake *pb.LiquidStake, now, at int64) float64 {
+	years := float64(now-at) / secondsPerYear
+	return stake.ExchangeRate / math.Pow(1+stake.Apy, years)
+}
+
+// firstReceipt returns the block time of the oldest transaction of any of
+// the token accounts, the one that first funded it. For accounts with more
+// history than GetTransactionHashesSince pages through, the oldest one found
+// is used.
+func firstReceipt(tokenAccounts []string) (int64, error) {
+	var first int64
+	for _, tokenAccount := range tokenAccounts {
+		hashes, _, err := solana_requests.GetTransactionHashesSince(tokenAccount, 0)
+		if err != nil {
+			return 0, err
+		}
+		if len(hashes) == 0 {
+			continue
+		}
+		if at := hashes[len(hashes)-1].BlockTime; first == 0 || at < first {
+			first = at
+		}
+	}
+	if first == 0 {
+		return 0, errors.New("token accounts have no transactions")
+	}
+	return first, nil
+}
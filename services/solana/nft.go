@@ -0,0 +1,132 @@
+package main
+
+import (
+	"sort"
+
+	"github.com/charmbracelet/log"
+
+	pb "solana/generated"
+	solana_requests "solana/requests/solana"
+	solana_types "solana/types/solana_rpc"
+)
+
+// isNftAccount reports whether a token account holds an NFT: exactly one
+// token of a mint without decimals.
+func isNftAccount(account solana_types.TokenAccount) bool {
+	amount := account.Account.Data.Parsed.Info.TokenAmount
+	return amount.Decimals == 0 && amount.Amount == "1"
+}
+
+// splitNftAccounts separates NFT token accounts from fungible ones.
+func splitNftAccounts(accounts []solana_types.TokenAccount) (fungible, nfts []solana_types.TokenAccount) {
+	for _, account := range accounts {
+		if isNftAccount(account) {
+			nfts = append(nfts, account)
+		} else {
+			fungible = append(fungible, account)
+		}
+	}
+	return fungible, nfts
+}
+
+// collectNfts builds the NFTs held in the given token accounts plus the
+// compressed NFTs of the owners, which only DAS can discover, and values them
+// at their collection's floor price.
+func (s *server) collectNfts(owners []string, accounts []solana_types.TokenAccount, solanaPrice float64) ([]*pb.Nft, []*pb.NftCollection) {
+	var nfts []*pb.Nft
+	for _, account := range accounts {
+		mint := account.Account.Data.Parsed.Info.Mint
+		data, err := solana_requests.GetTokenMetadata(mint)
+		if err != nil {
+			log.Warn("no metadata for NFT", "mint", mint, "error", err)
+		}
+		nfts = append(nfts, s.toNft(mint, data.Result, false))
+	}
+	for _, owner := range owners {
+		assets, err := solana_requests.GetAssetsByOwner(owner)
+		if err != nil {
+			log.Warn("failed to fetch compressed NFTs", "wallet", owner, "error", err)
+			continue
+		}
+		for _, asset := range assets {
+			if asset.Compression.Compressed {
+				nfts = append(nfts, s.toNft(asset.ID, asset, true))
+			}
+		}
+	}
+	return nfts, s.valueNftCollections(nfts, solanaPrice)
+}
+
+// toNft converts DAS or on-chain metadata into an Nft.
+func (s *server) toNft(mint string, asset solana_types.TokenMetaData, compressed bool) *pb.Nft {
+	s.resolveTokenContent(mint, &asset.Content)
+	nft := &pb.Nft{
+		Mint:       mint,
+		Name:       asset.Content.Metadata.Name,
+		Symbol:     asset.Content.Metadata.Symbol,
+		Image:      asset.Content.Links.Image,
+		Compressed: compressed,
+	}
+	for _, group := range asset.Grouping {
+		if group.GroupKey != "collection" {
+			continue
+		}
+		nft.Collection = group.GroupValue
+		nft.CollectionVerified = group.Verified == nil || *group.Verified
+		if group.CollectionMetadata != nil {
+			nft.CollectionName = group.CollectionMetadata.Name
+		}
+		break
+	}
+	return nft
+}
+
+// valueNftCollections groups NFTs by collection, looks up each collection's
+// name and floor price once and sets the value of every NFT. Only verified
+// collections are valued, since anyone can claim an unverified one.
+func (s *server) valueNftCollections(nfts []*pb.Nft, solanaPrice float64) []*pb.NftCollection {
+	byAddress := make(map[string]*pb.NftCollection)
+	var collections []*pb.NftCollection
+	for _, nft := range nfts {
+		collection, ok := byAddress[nft.Collection]
+		if !ok {
+			collection = &pb.NftCollection{Address: nft.Collection, Name: nft.CollectionName}
+			if nft.Collection != "" && nft.CollectionVerified {
+				collection.FloorPrice = s.floorPrice(nft.Collection)
+				if collection.Name == "" {
+					if data, err := solana_requests.GetTokenMetadata(nft.Collection); err == nil {
+						collection.Name = data.Result.Content.Metadata.Name
+					}
+				}
+			}
+			byAddress[nft.Collection] = collection
+			collections = append(collections, collection)
+		}
+		nft.CollectionName = collection.Name
+		if nft.CollectionVerified {
+			nft.FloorPrice = collection.FloorPrice
+			nft.Value = collection.FloorPrice * solanaPrice
+		}
+		collection.Count++
+		collection.Value += nft.Value
+	}
+	sort.SliceStable(collections, func(i, j int) bool {
+		return collections[i].Value > collections[j].Value
+	})
+	return collections
+}
+
+func (s *server) floorPrice(collection string) float64 {
+	if s.floors == nil {
+		return 0
+	}
+	price, ok, err := s.floors.FloorPrice(collection)
+	if err != nil {
+		log.Warn("failed to fetch floor price", "collection", collection, "error", err)
+		return 0
+	}
+	if !ok {
+		return 0
+	}
+	return price
+}
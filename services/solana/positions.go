@@ -0,0 +1,307 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/charmbracelet/log"
+
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	solana_requests "solana/requests/solana"
+	solana_types "solana/types/solana_rpc"
+)
+
+const (
+	protocolRaydiumAmm    = "raydium_amm"
+	protocolOrcaWhirlpool = "orca_whirlpool"
+	protocolRaydiumClmm   = "raydium_clmm"
+)
+
+// clmmProtocols names the concentrated liquidity programs whose position
+// NFTs we resolve.
+var clmmProtocols = map[string]string{
+	solana_requests.OrcaWhirlpoolProgramID: protocolOrcaWhirlpool,
+	solana_requests.RaydiumClmmProgramID:   protocolRaydiumClmm,
+}
+
+// positionAccount is a token account holding LP tokens or a position NFT.
+type positionAccount struct {
+	account  solana_types.TokenAccount
+	protocol string
+	// position is the decoded position account of a position NFT.
+	position *solana_requests.ClmmPosition
+}
+
+// splitPositionAccounts separates token accounts holding liquidity
+// positions from plain fungible tokens and NFTs. Raydium AMM v4 LP mints are
+// recognised by their mint authority; position NFTs by the position account
+// their mint derives.
+func splitPositionAccounts(fungible, nfts []solana_types.TokenAccount) (positions []positionAccount, restFungible, restNfts []solana_types.TokenAccount) {
+	lpMints := raydiumLpMints(fungible)
+	for _, account := range fungible {
+		if lpMints[account.Account.Data.Parsed.Info.Mint] {
+			positions = append(positions, positionAccount{account: account, protocol: protocolRaydiumAmm})
+		} else {
+			restFungible = append(restFungible, account)
+		}
+	}
+	clmmPositions := findClmmPositions(nfts)
+	for _, account := range nfts {
+		if position, ok := clmmPositions[account.Account.Data.Parsed.Info.Mint]; ok {
+			positions = append(positions, position)
+		} else {
+			restNfts = append(restNfts, account)
+		}
+	}
+	return positions, restFungible, restNfts
+}
+
+// raydiumLpMints returns the mints of the accounts whose mint authority is
+// the Raydium AMM v4 authority, which mints every v4 LP token.
+func raydiumLpMints(accounts []solana_types.TokenAccount) map[string]bool {
+	var mints []string
+	for _, account := range accounts {
+		mints = append(mints, account.Account.Data.Parsed.Info.Mint)
+	}
+	if len(mints) == 0 {
+		return nil
+	}
+	raw, err := solana_requests.RequestRawAccounts(mints)
+	if err != nil {
+		log.Warn("failed to fetch mints for LP detection", "error", err)
+		return nil
+	}
+	lpMints := make(map[string]bool)
+	for mint, account := range raw {
+		authority, err := solana_requests.DecodeMintAuthority(account.Data)
+		if err == nil && authority == solana_requests.RaydiumAmmV4Authority {
+			lpMints[mint] = true
+		}
+	}
+	return lpMints
+}
+
+// findClmmPositions maps NFT mints to their decoded concentrated liquidity
+// position, for the NFTs that are Orca or Raydium position NFTs.
+func findClmmPositions(accounts []solana_types.TokenAccount) map[string]positionAccount {
+	nftByAddress := make(map[string]solana_types.TokenAccount)
+	var addresses []string
+	for _, account := range accounts {
+		for programID := range clmmProtocols {
+			address, err := solana_requests.ClmmPositionAddress(programID, account.Account.Data.Parsed.Info.Mint)
+			if err != nil {
+				continue
+			}
+			nftByAddress[address] = account
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		return nil
+	}
+	raw, err := solana_requests.RequestRawAccounts(addresses)
+	if err != nil {
+		log.Warn("failed to fetch liquidity position accounts", "error", err)
+		return nil
+	}
+	positions := make(map[string]positionAccount)
+	for address, account := range raw {
+		protocol, ok := clmmProtocols[account.Owner]
+		if !ok {
+			continue
+		}
+		position, err := solana_requests.DecodeClmmPosition(account.Owner, address, account.Data)
+		nft := nftByAddress[address]
+		if err != nil || position.PositionMint != nft.Account.Data.Parsed.Info.Mint {
+			continue
+		}
+		positions[position.PositionMint] = positionAccount{account: nft, protocol: protocol, position: &position}
+	}
+	return positions
+}
+
+// valuePositions resolves positions into their underlying tokens and prices
+// them.
+func (s *server) valuePositions(accounts []positionAccount) []*pb.Position {
+	var positions []*pb.Position
+	for _, account := range accounts {
+		var position *pb.Position
+		var err error
+		if account.position == nil {
+			position, err = raydiumAmmPosition(account.account)
+		} else {
+			position, err = clmmPosition(account.protocol, *account.position)
+		}
+		if err != nil {
+			log.Warn("failed to resolve liquidity position", "mint", account.account.Account.Data.Parsed.Info.Mint, "error", err)
+			continue
+		}
+		positions = append(positions, position)
+	}
+	s.pricePositions(positions)
+	return positions
+}
+
+// raydiumAmmPosition resolves LP tokens into their share of the pool's
+// reserves. Reserves exclude the PnL the pool still owes to its OpenBook
+// market maker, and the share is taken of the pool's own LP accounting.
+func raydiumAmmPosition(account solana_types.TokenAccount) (*pb.Position, error) {
+	mint := account.Account.Data.Parsed.Info.Mint
+	pool, err := solana_requests.FindRaydiumAmmPoolByLpMint(mint)
+	if err != nil {
+		return nil, err
+	}
+	if pool.LpReserve == 0 {
+		return nil, fmt.Errorf("pool %s has no LP supply", pool.Address)
+	}
+	amount, err := strconv.ParseUint(account.Account.Data.Parsed.Info.TokenAmount.Amount, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing LP amount: %w", err)
+	}
+	vaults, err := solana_requests.RequestRawAccounts([]string{pool.BaseVault, pool.QuoteVault})
+	if err != nil {
+		return nil, err
+	}
+	baseReserve, err := vaultReserve(vaults, pool.BaseVault, pool.BaseNeedTakePnl)
+	if err != nil {
+		return nil, err
+	}
+	quoteReserve, err := vaultReserve(vaults, pool.QuoteVault, pool.QuoteNeedTakePnl)
+	if err != nil {
+		return nil, err
+	}
+	share := float64(amount) / float64(pool.LpReserve)
+	return &pb.Position{
+		Protocol: protocolRaydiumAmm,
+		Pool:     pool.Address,
+		Mint:     mint,
+		Share:    share,
+		Assets: []*pb.PositionAsset{
+			{Mint: pool.BaseMint, Amount: share * float64(baseReserve) / math.Pow10(int(pool.BaseDecimals))},
+			{Mint: pool.QuoteMint, Amount: share * float64(quoteReserve) / math.Pow10(int(pool.QuoteDecimals))},
+		},
+	}, nil
+}
+
+// vaultReserve returns a vault's balance less what the pool owes out of it.
+func vaultReserve(vaults map[string]solana_requests.RawAccount, vault string, owed uint64) (uint64, error) {
+	account, ok := vaults[vault]
+	if !ok {
+		return 0, fmt.Errorf("vault %s not found", vault)
+	}
+	balance, err := solana_requests.TokenAccountAmount(account.Data)
+	if err != nil {
+		return 0, err
+	}
+	if owed > balance {
+		return 0, nil
+	}
+	return balance - owed, nil
+}
+
+// clmmPosition resolves a concentrated liquidity position into the token
+// amounts its liquidity holds at the pool's current price.
+func clmmPosition(protocol string, position solana_requests.ClmmPosition) (*pb.Position, error) {
+	pool, err := solana_requests.GetClmmPool(position.Pool)
+	if err != nil {
+		return nil, err
+	}
+	mints, err := solana_requests.RequestRawAccounts([]string{pool.MintA, pool.MintB})
+	if err != nil {
+		return nil, err
+	}
+	decimalsA, err := solana_requests.DecodeMintDecimals(mints[pool.MintA].Data)
+	if err != nil {
+		return nil, fmt.Errorf("mint %s: %w", pool.MintA, err)
+	}
+	decimalsB, err := solana_requests.DecodeMintDecimals(mints[pool.MintB].Data)
+	if err != nil {
+		return nil, fmt.Errorf("mint %s: %w", pool.MintB, err)
+	}
+	amountA, amountB := clmmAmounts(position.Liquidity, pool.SqrtPrice, position.TickLower, position.TickUpper)
+	scaleA, scaleB := math.Pow10(decimalsA), math.Pow10(decimalsB)
+	return &pb.Position{
+		Protocol:    protocol,
+		Pool:        pool.Address,
+		Mint:        position.PositionMint,
+		Address:     position.Address,
+		TickLower:   position.TickLower,
+		TickUpper:   position.TickUpper,
+		TickCurrent: pool.TickCurrent,
+		InRange:     position.TickLower <= pool.TickCurrent && pool.TickCurrent < position.TickUpper,
+		Assets: []*pb.PositionAsset{
+			{Mint: pool.MintA, Amount: amountA / scaleA, FeesOwed: float64(position.FeesOwedA) / scaleA},
+			{Mint: pool.MintB, Amount: amountB / scaleB, FeesOwed: float64(position.FeesOwedB) / scaleB},
+		},
+	}, nil
+}
+
+// clmmAmounts returns the raw token amounts held by liquidity between two
+// ticks. Below the range it is all token A, above it all token B, and in
+// range it is split at the current price.
+func clmmAmounts(liquidity, sqrtPrice float64, tickLower, tickUpper int32) (amountA, amountB float64) {
+	sqrtLower := tickSqrtPrice(tickLower)
+	sqrtUpper := tickSqrtPrice(tickUpper)
+	switch {
+	case sqrtPrice <= sqrtLower:
+		amountA = liquidity * (sqrtUpper - sqrtLower) / (sqrtLower * sqrtUpper)
+	case sqrtPrice >= sqrtUpper:
+		amountB = liquidity * (sqrtUpper - sqrtLower)
+	default:
+		amountA = liquidity * (sqrtUpper - sqrtPrice) / (sqrtPrice * sqrtUpper)
+		amountB = liquidity * (sqrtPrice - sqrtLower)
+	}
+	return amountA, amountB
+}
+
+// tickSqrtPrice returns the square root of the price at a tick, 1.0001^tick.
+func tickSqrtPrice(tick int32) float64 {
+	return math.Pow(1.0001, float64(tick)/2)
+}
+
+// pricePositions prices the underlying tokens of all positions in one
+// request and totals each position's value and fees.
+func (s *server) pricePositions(positions []*pb.Position) {
+	seen := make(map[string]bool)
+	var mints []string
+	for _, position := range positions {
+		for _, asset := range position.Assets {
+			if !seen[asset.Mint] {
+				seen[asset.Mint] = true
+				mints = append(mints, asset.Mint)
+			}
+		}
+	}
+	if len(mints) == 0 {
+		return
+	}
+	prices, err := coingecko_requests.GetCoinGeckoTokenPrices(mints)
+	if err != nil {
+		log.Warn("failed to price liquidity positions", "error", err)
+		return
+	}
+	for _, position := range positions {
+		for _, asset := range position.Assets {
+			if listed, ok := s.verified.Lookup(asset.Mint); ok {
+				asset.Symbol = listed.Symbol
+			}
+			asset.Price, _ = strconv.ParseFloat(prices[asset.Mint], 64)
+			asset.Value = asset.Amount * asset.Price
+			asset.FeesValue = asset.FeesOwed * asset.Price
+			position.Value += asset.Value
+			position.FeesValue += asset.FeesValue
+		}
+	}
+}
+
+// applyPositions sets the positions of a response and adds their value,
+// fees included, to its wallet value.
+func applyPositions(response *pb.WalletResponse, positions []*pb.Position) {
+	response.Positions = positions
+	for _, position := range positions {
+		response.PositionValue += position.Value + position.FeesValue
+	}
+	response.WalletValue += response.PositionValue
+}
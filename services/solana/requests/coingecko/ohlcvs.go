@@ -5,13 +5,120 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	coingecko_types "solana/types/coingecko"
+	"strconv"
+	"time"
 
 	"github.com/charmbracelet/log"
 )
 
-func GetOHLCVS(address string, timeframe string, start int64, end int64) ([][]float64, error) {
-	request_url := fmt.Sprintf("https://api.geckoterminal.com/api/v2/networks/solana/pools/%s/ohlcv/%s?currency=usd", address, timeframe)
+// maxOHLCVLimit is the largest page GeckoTerminal returns per request.
+const maxOHLCVLimit = 1000
+
+// maxOHLCVPages bounds how far back a single range request pages so a huge
+// window on a fine resolution cannot burn through the rate limit.
+const maxOHLCVPages = 10
+
+// MaxOHLCVCandles is the most candles GetOHLCVS returns for a range. Longer
+// windows are cut short, so callers should reject them up front.
+const MaxOHLCVCandles = maxOHLCVLimit * maxOHLCVPages
+
+// Resolution is a candle size: a GeckoTerminal timeframe plus how many units
+// of it are aggregated into one candle.
+type Resolution struct {
+	Timeframe string
+	Aggregate int
+}
+
+// Seconds returns the length of one candle.
+func (r Resolution) Seconds() int64 {
+	switch r.Timeframe {
+	case "day":
+		return int64(r.Aggregate) * 86400
+	case "hour":
+		return int64(r.Aggregate) * 3600
+	default:
+		return int64(r.Aggregate) * 60
+	}
+}
+
+// DefaultResolution is used when the client does not ask for one.
+var DefaultResolution = Resolution{Timeframe: "minute", Aggregate: 1}
+
+// resolutions lists the candle sizes GeckoTerminal supports.
+var resolutions = map[string]Resolution{
+	"1m":  {Timeframe: "minute", Aggregate: 1},
+	"5m":  {Timeframe: "minute", Aggregate: 5},
+	"15m": {Timeframe: "minute", Aggregate: 15},
+	"1h":  {Timeframe: "hour", Aggregate: 1},
+	"4h":  {Timeframe: "hour", Aggregate: 4},
+	"12h": {Timeframe: "hour", Aggregate: 12},
+	"1d":  {Timeframe: "day", Aggregate: 1},
+}
+
+// ParseResolution maps a resolution such as "15m", "4h" or "1d" to its
+// GeckoTerminal timeframe and aggregate. An empty string yields the default.
+func ParseResolution(resolution string) (Resolution, error) {
+	if resolution == "" {
+		return DefaultResolution, nil
+	}
+	r, ok := resolutions[resolution]
+	if !ok {
+		return Resolution{}, fmt.Errorf("unsupported resolution %q", resolution)
+	}
+	return r, nil
+}
+
+// GetOHLCVS returns the candles of a pool between start and end (unix
+// seconds), newest first. An end of 0 means now; a start of 0 returns a
+// single page ending at end. Windows longer than one page are fetched by
+// paging backwards with before_timestamp.
+func GetOHLCVS(address string, resolution Resolution, start int64, end int64) ([][]float64, error) {
+	if end == 0 {
+		end = time.Now().Unix()
+	}
+	if start == 0 {
+		return getOHLCVPage(address, resolution, end, maxOHLCVLimit)
+	}
+
+	var candles [][]float64
+	before := end
+	for page := 0; page < maxOHLCVPages && before > start; page++ {
+		limit := (before-start)/resolution.Seconds() + 1
+		if limit > maxOHLCVLimit {
+			limit = maxOHLCVLimit
+		}
+		batch, err := getOHLCVPage(address, resolution, before, int(limit))
+		if err != nil {
+			return nil, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, candle := range batch {
+			if int64(candle[0]) >= start {
+				candles = append(candles, candle)
+			}
+		}
+		oldest := int64(batch[len(batch)-1][0])
+		if oldest >= before {
+			break
+		}
+		// Step past the oldest candle so the next page does not repeat it.
+		before = oldest - 1
+	}
+	return candles, nil
+}
+
+// getOHLCVPage fetches up to limit candles closing before the given timestamp.
+func getOHLCVPage(address string, resolution Resolution, before int64, limit int) ([][]float64, error) {
+	query := url.Values{}
+	query.Set("currency", "usd")
+	query.Set("aggregate", strconv.Itoa(resolution.Aggregate))
+	query.Set("before_timestamp", strconv.FormatInt(before, 10))
+	query.Set("limit", strconv.Itoa(limit))
+	request_url := fmt.Sprintf("https://api.geckoterminal.com/api/v2/networks/solana/pools/%s/ohlcv/%s?%s", address, resolution.Timeframe, query.Encode())
 	resp, err := http.Get(request_url)
 	if err != nil {
 		return nil, err
@@ -19,7 +126,6 @@ func GetOHLCVS(address string, timeframe string, start int64, end int64) ([][]fl
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-
 		return nil, err
 	}
 	var response coingecko_types.OHLCVSResponse
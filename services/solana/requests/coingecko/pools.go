@@ -6,27 +6,100 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
 	coingecko_types "solana/types/coingecko"
 )
 
+// preferredQuoteTokens are the quote assets whose pools we trust for pricing.
+// Pools quoted in anything else are only used when a token has no SOL or
+// USDC pool at all.
+var preferredQuoteTokens = map[string]bool{
+	"So11111111111111111111111111111111111111112":  true, // SOL
+	"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v": true, // USDC
+}
+
 func GetTokenPools(address string) (string, error) {
+	pools, err := RankTokenPools(address)
+	if err != nil {
+		return "", err
+	}
+	return pools[0].Address, nil
+}
+
+// RankTokenPools returns the pools trading the given token, best first.
+// Pools quoted in SOL or USDC win over other quote tokens, pools with 24h
+// volume win over dead ones, and within those the deepest reserve wins.
+func RankTokenPools(address string) ([]coingecko_types.RankedPool, error) {
 	request_url := fmt.Sprintf("https://api.geckoterminal.com/api/v2/networks/solana/tokens/%s/pools?page=1", address)
 	resp, err := http.Get(request_url)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	var response coingecko_types.PoolResponse
 	err = json.Unmarshal([]byte(body), &response)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if len(response.Data) == 0 {
-		return "", errors.New("no pools")
+		return nil, errors.New("no pools")
 	}
-	return response.Data[0].Attributes.Address, nil
+
+	var preferred, others []coingecko_types.RankedPool
+	for _, pool := range response.Data {
+		ranked := rankedPool(address, pool)
+		if preferredQuoteTokens[ranked.QuoteToken] {
+			preferred = append(preferred, ranked)
+		} else {
+			others = append(others, ranked)
+		}
+	}
+	sortByLiquidity(preferred)
+	sortByLiquidity(others)
+	return append(preferred, others...), nil
+}
+
+// rankedPool flattens a GeckoTerminal pool into the figures used for ranking.
+// The quote token is whichever side of the pair is not the token itself.
+func rankedPool(token string, pool coingecko_types.Pool) coingecko_types.RankedPool {
+	base := trimNetworkPrefix(pool.Relationships.BaseToken.Data.ID)
+	quote := trimNetworkPrefix(pool.Relationships.QuoteToken.Data.ID)
+	if quote == token {
+		quote = base
+	}
+	reserve, _ := strconv.ParseFloat(pool.Attributes.ReserveInUSD, 64)
+	volume, _ := strconv.ParseFloat(pool.Attributes.VolumeUSD.H24, 64)
+	return coingecko_types.RankedPool{
+		Address:      pool.Attributes.Address,
+		Name:         pool.Attributes.Name,
+		Dex:          pool.Relationships.Dex.Data.ID,
+		QuoteToken:   quote,
+		ReserveInUSD: reserve,
+		VolumeH24USD: volume,
+	}
+}
+
+func sortByLiquidity(pools []coingecko_types.RankedPool) {
+	sort.SliceStable(pools, func(i, j int) bool {
+		iActive, jActive := pools[i].VolumeH24USD > 0, pools[j].VolumeH24USD > 0
+		if iActive != jActive {
+			return iActive
+		}
+		if pools[i].ReserveInUSD != pools[j].ReserveInUSD {
+			return pools[i].ReserveInUSD > pools[j].ReserveInUSD
+		}
+		return pools[i].VolumeH24USD > pools[j].VolumeH24USD
+	})
+}
+
+// trimNetworkPrefix turns a GeckoTerminal id such as "solana_<mint>" into the mint.
+func trimNetworkPrefix(id string) string {
+	return strings.TrimPrefix(id, "solana_")
 }
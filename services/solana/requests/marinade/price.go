@@ -0,0 +1,55 @@
+package marinade_requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	marinade_types "solana/types/marinade"
+	"strconv"
+	"strings"
+)
+
+const (
+	MsolMint    = "mSoLzYCxHdYgdzU16g5QSh3i5K3z3KZK7ytfqcJm7So"
+	marinadeAPI = "https://api.marinade.finance"
+)
+
+// GetMsolPrice returns the SOL backing one mSOL. Marinade is not an SPL
+// stake pool, so its exchange rate comes from the Marinade API.
+func GetMsolPrice() (float64, error) {
+	body, err := get(marinadeAPI + "/msol/price_sol")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get mSOL price: %w", err)
+	}
+	price, err := strconv.ParseFloat(strings.TrimSpace(string(body)), 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse mSOL price: %w", err)
+	}
+	return price, nil
+}
+
+// GetMsolAPY returns mSOL's annualized yield over the last 30 days.
+func GetMsolAPY() (float64, error) {
+	body, err := get(marinadeAPI + "/msol/apy/30d")
+	if err != nil {
+		return 0, fmt.Errorf("failed to get mSOL APY: %w", err)
+	}
+	var apy marinade_types.APYResponse
+	if err := json.Unmarshal(body, &apy); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal mSOL APY response: %w", err)
+	}
+	return apy.Value, nil
+}
+
+func get(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
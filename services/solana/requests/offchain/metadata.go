@@ -0,0 +1,108 @@
+package offchain_requests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"solana/safehttp"
+	offchain_types "solana/types/offchain"
+	"strings"
+	"time"
+)
+
+// maxJSONSize caps how much of an off-chain metadata document we read.
+const maxJSONSize = 1 << 20
+
+var (
+	ipfsGateway    = "https://ipfs.io/ipfs/"
+	arweaveGateway = "https://arweave.net/"
+	httpClient     = safehttp.NewClient(15 * time.Second)
+)
+
+// SetGateways replaces the IPFS and Arweave gateways uris are resolved to.
+// Empty values keep the current gateway.
+func SetGateways(ipfs, arweave string) {
+	if ipfs != "" {
+		ipfsGateway = ipfs
+	}
+	if arweave != "" {
+		arweaveGateway = arweave
+	}
+}
+
+// ResolveURI turns ipfs:// and ar:// uris, as well as links to arbitrary IPFS
+// gateways, into plain https URLs on the configured gateways. Anything else is
+// returned unchanged.
+func ResolveURI(uri string) string {
+	uri = strings.TrimSpace(uri)
+	switch {
+	case strings.HasPrefix(uri, "ipfs://"):
+		path := strings.TrimPrefix(uri, "ipfs://")
+		path = strings.TrimPrefix(path, "ipfs/")
+		return ipfsGateway + path
+	case strings.HasPrefix(uri, "ar://"):
+		return arweaveGateway + strings.TrimPrefix(uri, "ar://")
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	if _, path, found := strings.Cut(parsed.Path, "/ipfs/"); found && path != "" {
+		resolved := ipfsGateway + path
+		if parsed.RawQuery != "" {
+			resolved += "?" + parsed.RawQuery
+		}
+		return resolved
+	}
+	if strings.HasSuffix(parsed.Host, ".ipfs.dweb.link") || strings.HasSuffix(parsed.Host, ".ipfs.nftstorage.link") {
+		cid, _, _ := strings.Cut(parsed.Host, ".")
+		return ipfsGateway + cid + parsed.Path
+	}
+	return uri
+}
+
+// GetJSONMetadata fetches and validates the off-chain JSON metadata of a token.
+func GetJSONMetadata(uri string) (offchain_types.TokenJSONMetadata, error) {
+	requestURL := ResolveURI(uri)
+	if err := safehttp.ValidateURL(requestURL); err != nil {
+		return offchain_types.TokenJSONMetadata{}, err
+	}
+	resp, err := httpClient.Get(requestURL)
+	if err != nil {
+		return offchain_types.TokenJSONMetadata{}, fmt.Errorf("failed to fetch metadata json: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return offchain_types.TokenJSONMetadata{}, fmt.Errorf("metadata json returned status %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxJSONSize+1))
+	if err != nil {
+		return offchain_types.TokenJSONMetadata{}, fmt.Errorf("failed to read metadata json: %w", err)
+	}
+	if len(body) > maxJSONSize {
+		return offchain_types.TokenJSONMetadata{}, errors.New("metadata json too large")
+	}
+	var metadata offchain_types.TokenJSONMetadata
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return offchain_types.TokenJSONMetadata{}, fmt.Errorf("failed to unmarshal metadata json: %w", err)
+	}
+	if metadata.Image == "" {
+		// Some collections only list the image under properties.files.
+		for _, file := range metadata.Properties.Files {
+			if strings.HasPrefix(file.Type, "image/") {
+				metadata.Image = file.URI
+				break
+			}
+		}
+	}
+	if metadata.Image != "" && safehttp.ValidateURL(ResolveURI(metadata.Image)) != nil {
+		metadata.Image = ""
+	}
+	if metadata.Name == "" && metadata.Image == "" {
+		return offchain_types.TokenJSONMetadata{}, errors.New("metadata json has neither name nor image")
+	}
+	return metadata, nil
+}
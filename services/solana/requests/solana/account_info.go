@@ -1,7 +1,11 @@
 package solana_requests
 
 import (
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	solana_types "solana/types/solana_rpc"
 
@@ -36,3 +40,141 @@ func RequestAccountInfo(address string) (Wallet, error) {
 	floatValue := float64(walletresponse.Result.Value) / divisor
 	return Wallet{response, floatValue}, nil
 }
+
+// RawAccount is an account's owner program and decoded data bytes.
+type RawAccount struct {
+	Owner    string
+	Lamports int64
+	Data     []byte
+}
+
+// RequestRawAccount fetches an account with base64 encoding and decodes its
+// data, for accounts whose layout we parse ourselves.
+func RequestRawAccount(address string) (RawAccount, error) {
+	data, err := queryRPC("getAccountInfo", []interface{}{
+		address,
+		map[string]interface{}{
+			"encoding": "base64",
+		},
+	})
+	if err != nil {
+		return RawAccount{}, err
+	}
+	var response solana_types.RawAccountInfoResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		return RawAccount{}, err
+	}
+	if response.Error != nil {
+		return RawAccount{}, fmt.Errorf("getAccountInfo: %s", response.Error.Message)
+	}
+	if response.Result.Value == nil {
+		return RawAccount{}, fmt.Errorf("account %s not found", address)
+	}
+	return decodeRawAccount(*response.Result.Value)
+}
+
+// RequestRawAccounts fetches accounts with base64 encoding in batches and
+// maps each existing address to its decoded account.
+func RequestRawAccounts(addresses []string) (map[string]RawAccount, error) {
+	accounts := make(map[string]RawAccount)
+	for start := 0; start < len(addresses); start += maxMultipleAccounts {
+		end := min(start+maxMultipleAccounts, len(addresses))
+		batch := addresses[start:end]
+		data, err := queryRPC("getMultipleAccounts", []interface{}{
+			batch,
+			map[string]interface{}{
+				"encoding": "base64",
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var response solana_types.RawMultipleAccountsResponse
+		if err := json.Unmarshal([]byte(data), &response); err != nil {
+			return nil, err
+		}
+		if response.Error != nil {
+			return nil, fmt.Errorf("getMultipleAccounts: %s", response.Error.Message)
+		}
+		for i, value := range response.Result.Value {
+			if value == nil || i >= len(batch) {
+				continue
+			}
+			account, err := decodeRawAccount(*value)
+			if err != nil {
+				continue
+			}
+			accounts[batch[i]] = account
+		}
+	}
+	return accounts, nil
+}
+
+// programAccount is an account returned by getProgramAccounts, with its
+// data decoded.
+type programAccount struct {
+	Address string
+	RawAccount
+}
+
+// getRawProgramAccounts fetches the accounts of a program matching the
+// filters with base64 encoding.
+func getRawProgramAccounts(programID string, filters ...interface{}) ([]programAccount, error) {
+	data, err := queryRPC("getProgramAccounts", []interface{}{
+		programID,
+		map[string]interface{}{
+			"encoding": "base64",
+			"filters":  filters,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var response solana_types.RawProgramAccountsResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("getProgramAccounts: %s", response.Error.Message)
+	}
+	var accounts []programAccount
+	for _, result := range response.Result {
+		account, err := decodeRawAccount(result.Account)
+		if err != nil {
+			continue
+		}
+		accounts = append(accounts, programAccount{Address: result.Pubkey, RawAccount: account})
+	}
+	return accounts, nil
+}
+
+// memcmpFilter matches accounts whose data at offset equals the base58
+// encoded bytes.
+func memcmpFilter(offset int, bytes string) map[string]interface{} {
+	return map[string]interface{}{
+		"memcmp": map[string]interface{}{
+			"offset": offset,
+			"bytes":  bytes,
+		},
+	}
+}
+
+// TokenAccountAmount reads the raw amount of an SPL token account, which
+// follows its 32 byte mint and 32 byte owner.
+func TokenAccountAmount(data []byte) (uint64, error) {
+	if len(data) < 72 {
+		return 0, errShortBuffer
+	}
+	return binary.LittleEndian.Uint64(data[64:72]), nil
+}
+
+func decodeRawAccount(value solana_types.RawAccountInfoValue) (RawAccount, error) {
+	if len(value.Data) == 0 {
+		return RawAccount{}, errors.New("account data missing")
+	}
+	decoded, err := base64.StdEncoding.DecodeString(value.Data[0])
+	if err != nil {
+		return RawAccount{}, err
+	}
+	return RawAccount{Owner: value.Owner, Lamports: value.Lamports, Data: decoded}, nil
+}
@@ -0,0 +1,85 @@
+package solana_requests
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/mr-tron/base58"
+)
+
+var errShortBuffer = errors.New("account data too short")
+
+// borshReader reads the little-endian Borsh encoding used by most Solana
+// programs. The first failed read sticks in err and turns every following
+// read into a no-op, so callers only check err once at the end.
+type borshReader struct {
+	data   []byte
+	offset int
+	err    error
+}
+
+func newBorshReader(data []byte) *borshReader {
+	return &borshReader{data: data}
+}
+
+func (r *borshReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || r.offset+n > len(r.data) {
+		r.err = errShortBuffer
+		return nil
+	}
+	b := r.data[r.offset : r.offset+n]
+	r.offset += n
+	return b
+}
+
+func (r *borshReader) skip(n int) {
+	r.next(n)
+}
+
+func (r *borshReader) u8() uint8 {
+	b := r.next(1)
+	if b == nil {
+		return 0
+	}
+	return b[0]
+}
+
+func (r *borshReader) u16() uint16 {
+	b := r.next(2)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint16(b)
+}
+
+func (r *borshReader) u32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (r *borshReader) u64() uint64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.LittleEndian.Uint64(b)
+}
+
+func (r *borshReader) pubkey() string {
+	b := r.next(32)
+	if b == nil {
+		return ""
+	}
+	return base58.Encode(b)
+}
+
+func (r *borshReader) string() string {
+	n := r.u32()
+	return string(r.next(int(n)))
+}
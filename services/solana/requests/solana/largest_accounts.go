@@ -0,0 +1,59 @@
+package solana_requests
+
+import (
+	"encoding/json"
+	"fmt"
+	solana_types "solana/types/solana_rpc"
+)
+
+// maxMultipleAccounts is the most accounts getMultipleAccounts accepts at once.
+const maxMultipleAccounts = 100
+
+// GetTokenLargestAccounts returns the 20 largest token accounts of a mint.
+func GetTokenLargestAccounts(mint string) ([]solana_types.TokenLargestAccount, error) {
+	data, err := queryRPC("getTokenLargestAccounts", []interface{}{mint})
+	if err != nil {
+		return nil, err
+	}
+	var response solana_types.TokenLargestAccountsResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("getTokenLargestAccounts: %s", response.Error.Message)
+	}
+	return response.Result.Value, nil
+}
+
+// RequestTokenAccountOwners maps token accounts to the wallets owning them.
+// Accounts that do not exist or are not token accounts are left out.
+func RequestTokenAccountOwners(tokenAccounts []string) (map[string]string, error) {
+	owners := make(map[string]string)
+	for start := 0; start < len(tokenAccounts); start += maxMultipleAccounts {
+		end := min(start+maxMultipleAccounts, len(tokenAccounts))
+		batch := tokenAccounts[start:end]
+		data, err := queryRPC("getMultipleAccounts", []interface{}{
+			batch,
+			map[string]interface{}{
+				"encoding": "jsonParsed",
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var response solana_types.MultipleAccountsResponse
+		if err := json.Unmarshal([]byte(data), &response); err != nil {
+			return nil, err
+		}
+		if response.Error != nil {
+			return nil, fmt.Errorf("getMultipleAccounts: %s", response.Error.Message)
+		}
+		for i, account := range response.Result.Value {
+			if account == nil || i >= len(batch) || account.Data.Parsed.Info.Owner == "" {
+				continue
+			}
+			owners[batch[i]] = account.Data.Parsed.Info.Owner
+		}
+	}
+	return owners, nil
+}
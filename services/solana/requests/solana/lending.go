@@ -0,0 +1,297 @@
+package solana_requests
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	KaminoLendProgramID = "KLend2g3cP87fffoy8q1mQqGKjrxjC8boSyAYavgmjD"
+	MarginfiProgramID   = "MFv2hWf31Z9kbCa1snEPYctwafyhdvnV7FZnsebVacA"
+	SolendProgramID     = "So1endDq2YkqhipRh3WViPa8hdiSpxWy6z3Z6tMCpAo"
+)
+
+// Offsets into Kamino's Obligation and Reserve accounts. Values suffixed
+// _sf are u128 fixed point numbers with 60 fractional bits.
+const (
+	kaminoObligationOwner          = 64
+	kaminoObligationDeposits       = 96
+	kaminoDepositSize              = 136
+	kaminoMaxDeposits              = 8
+	kaminoDepositedValue           = 1192
+	kaminoObligationBorrows        = 1208
+	kaminoBorrowSize               = 200
+	kaminoMaxBorrows               = 5
+	kaminoBorrowFactorAdjustedDebt = 2208
+	kaminoBorrowedAssetsValue      = 2224
+	kaminoAllowedBorrowValue       = 2240
+	kaminoUnhealthyBorrowValue     = 2256
+	kaminoReserveLiquidityMint     = 128
+	kaminoReserveMarketPrice       = 248
+	kaminoReserveMintDecimals      = 272
+	kaminoFractionBits             = 60
+)
+
+// Offsets into MarginFi's MarginfiAccount and Bank accounts. Share values
+// are I80F48 fixed point numbers.
+const (
+	marginfiAccountAuthority = 40
+	marginfiBalances         = 72
+	marginfiBalanceSize      = 104
+	marginfiMaxBalances      = 16
+	marginfiBankMint         = 8
+	marginfiBankDecimals     = 40
+	marginfiAssetShareValue  = 80
+	marginfiLiabilityShare   = 96
+	marginfiFractionBits     = 48
+)
+
+// Offsets into Solend's Obligation. Values are u128 WADs.
+const (
+	solendObligationSize  = 1300
+	solendObligationOwner = 42
+	solendDepositedValue  = 74
+	solendBorrowedValue   = 90
+	solendAllowedBorrow   = 106
+	solendUnhealthyBorrow = 122
+	solendWad             = 1e18
+)
+
+// KaminoObligation is a Kamino Lend obligation. Values are in USD as of the
+// obligation's last refresh.
+type KaminoObligation struct {
+	Address                  string
+	Deposits                 []KaminoDeposit
+	Borrows                  []KaminoBorrow
+	DepositedValue           float64
+	BorrowedValue            float64
+	BorrowFactorAdjustedDebt float64
+	AllowedBorrowValue       float64
+	UnhealthyBorrowValue     float64
+}
+
+// KaminoDeposit is collateral deposited into a reserve.
+type KaminoDeposit struct {
+	Reserve     string
+	MarketValue float64
+}
+
+// KaminoBorrow is liquidity borrowed from a reserve. Amount is raw, in the
+// reserve's liquidity token.
+type KaminoBorrow struct {
+	Reserve     string
+	Amount      float64
+	MarketValue float64
+}
+
+// KaminoReserve holds the fields of a Kamino reserve we use.
+type KaminoReserve struct {
+	LiquidityMint string
+	Decimals      int
+	MarketPrice   float64
+}
+
+// MarginfiAccount is a MarginFi lending account and its active balances.
+type MarginfiAccount struct {
+	Address  string
+	Balances []MarginfiBalance
+}
+
+// MarginfiBalance is a position in one bank, held as shares.
+type MarginfiBalance struct {
+	Bank            string
+	AssetShares     float64
+	LiabilityShares float64
+}
+
+// MarginfiBank holds the fields of a MarginFi bank we use. Share values
+// convert shares into raw token amounts.
+type MarginfiBank struct {
+	Mint                string
+	Decimals            int
+	AssetShareValue     float64
+	LiabilityShareValue float64
+}
+
+// SolendObligation is a Solend obligation's totals in USD as of its last
+// refresh.
+type SolendObligation struct {
+	Address              string
+	DepositedValue       float64
+	BorrowedValue        float64
+	AllowedBorrowValue   float64
+	UnhealthyBorrowValue float64
+}
+
+// GetKaminoObligations returns the Kamino Lend obligations a wallet owns.
+func GetKaminoObligations(owner string) ([]KaminoObligation, error) {
+	accounts, err := getRawProgramAccounts(KaminoLendProgramID,
+		memcmpFilter(0, anchorDiscriminator("Obligation")),
+		memcmpFilter(kaminoObligationOwner, owner),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var obligations []KaminoObligation
+	for _, account := range accounts {
+		if len(account.Data) < kaminoUnhealthyBorrowValue+16 {
+			continue
+		}
+		obligations = append(obligations, decodeKaminoObligation(account.Address, account.Data))
+	}
+	return obligations, nil
+}
+
+func decodeKaminoObligation(address string, data []byte) KaminoObligation {
+	obligation := KaminoObligation{
+		Address:                  address,
+		DepositedValue:           fixed(data[kaminoDepositedValue:], kaminoFractionBits),
+		BorrowedValue:            fixed(data[kaminoBorrowedAssetsValue:], kaminoFractionBits),
+		BorrowFactorAdjustedDebt: fixed(data[kaminoBorrowFactorAdjustedDebt:], kaminoFractionBits),
+		AllowedBorrowValue:       fixed(data[kaminoAllowedBorrowValue:], kaminoFractionBits),
+		UnhealthyBorrowValue:     fixed(data[kaminoUnhealthyBorrowValue:], kaminoFractionBits),
+	}
+	for i := 0; i < kaminoMaxDeposits; i++ {
+		deposit := data[kaminoObligationDeposits+i*kaminoDepositSize:]
+		if binary.LittleEndian.Uint64(deposit[32:40]) == 0 {
+			continue
+		}
+		obligation.Deposits = append(obligation.Deposits, KaminoDeposit{
+			Reserve:     base58.Encode(deposit[0:32]),
+			MarketValue: fixed(deposit[40:], kaminoFractionBits),
+		})
+	}
+	for i := 0; i < kaminoMaxBorrows; i++ {
+		borrow := data[kaminoObligationBorrows+i*kaminoBorrowSize:]
+		amount := fixed(borrow[88:], kaminoFractionBits)
+		if amount == 0 {
+			continue
+		}
+		obligation.Borrows = append(obligation.Borrows, KaminoBorrow{
+			Reserve:     base58.Encode(borrow[0:32]),
+			Amount:      amount,
+			MarketValue: fixed(borrow[104:], kaminoFractionBits),
+		})
+	}
+	return obligation
+}
+
+// GetKaminoReserves fetches and decodes Kamino reserves by address.
+func GetKaminoReserves(addresses []string) (map[string]KaminoReserve, error) {
+	accounts, err := RequestRawAccounts(addresses)
+	if err != nil {
+		return nil, err
+	}
+	reserves := make(map[string]KaminoReserve)
+	for address, account := range accounts {
+		if account.Owner != KaminoLendProgramID || len(account.Data) < kaminoReserveMintDecimals+8 {
+			continue
+		}
+		reserves[address] = KaminoReserve{
+			LiquidityMint: base58.Encode(account.Data[kaminoReserveLiquidityMint : kaminoReserveLiquidityMint+32]),
+			MarketPrice:   fixed(account.Data[kaminoReserveMarketPrice:], kaminoFractionBits),
+			Decimals:      int(binary.LittleEndian.Uint64(account.Data[kaminoReserveMintDecimals:])),
+		}
+	}
+	return reserves, nil
+}
+
+// GetMarginfiAccounts returns the MarginFi accounts a wallet is the
+// authority of.
+func GetMarginfiAccounts(owner string) ([]MarginfiAccount, error) {
+	accounts, err := getRawProgramAccounts(MarginfiProgramID,
+		memcmpFilter(0, anchorDiscriminator("MarginfiAccount")),
+		memcmpFilter(marginfiAccountAuthority, owner),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var result []MarginfiAccount
+	for _, account := range accounts {
+		if len(account.Data) < marginfiBalances+marginfiMaxBalances*marginfiBalanceSize {
+			continue
+		}
+		marginfi := MarginfiAccount{Address: account.Address}
+		for i := 0; i < marginfiMaxBalances; i++ {
+			balance := account.Data[marginfiBalances+i*marginfiBalanceSize:]
+			if balance[0] == 0 {
+				continue
+			}
+			marginfi.Balances = append(marginfi.Balances, MarginfiBalance{
+				Bank:            base58.Encode(balance[1:33]),
+				AssetShares:     signedFixed(balance[40:], marginfiFractionBits),
+				LiabilityShares: signedFixed(balance[56:], marginfiFractionBits),
+			})
+		}
+		result = append(result, marginfi)
+	}
+	return result, nil
+}
+
+// GetMarginfiBanks fetches and decodes MarginFi banks by address.
+func GetMarginfiBanks(addresses []string) (map[string]MarginfiBank, error) {
+	accounts, err := RequestRawAccounts(addresses)
+	if err != nil {
+		return nil, err
+	}
+	banks := make(map[string]MarginfiBank)
+	for address, account := range accounts {
+		if account.Owner != MarginfiProgramID || len(account.Data) < marginfiLiabilityShare+16 {
+			continue
+		}
+		banks[address] = MarginfiBank{
+			Mint:                base58.Encode(account.Data[marginfiBankMint : marginfiBankMint+32]),
+			Decimals:            int(account.Data[marginfiBankDecimals]),
+			AssetShareValue:     signedFixed(account.Data[marginfiAssetShareValue:], marginfiFractionBits),
+			LiabilityShareValue: signedFixed(account.Data[marginfiLiabilityShare:], marginfiFractionBits),
+		}
+	}
+	return banks, nil
+}
+
+// GetSolendObligations returns the Solend obligations a wallet owns.
+func GetSolendObligations(owner string) ([]SolendObligation, error) {
+	accounts, err := getRawProgramAccounts(SolendProgramID,
+		map[string]interface{}{"dataSize": solendObligationSize},
+		memcmpFilter(solendObligationOwner, owner),
+	)
+	if err != nil {
+		return nil, err
+	}
+	var obligations []SolendObligation
+	for _, account := range accounts {
+		if len(account.Data) < solendUnhealthyBorrow+16 {
+			continue
+		}
+		obligations = append(obligations, SolendObligation{
+			Address:              account.Address,
+			DepositedValue:       u128(account.Data[solendDepositedValue:]) / solendWad,
+			BorrowedValue:        u128(account.Data[solendBorrowedValue:]) / solendWad,
+			AllowedBorrowValue:   u128(account.Data[solendAllowedBorrow:]) / solendWad,
+			UnhealthyBorrowValue: u128(account.Data[solendUnhealthyBorrow:]) / solendWad,
+		})
+	}
+	return obligations, nil
+}
+
+// anchorDiscriminator returns the base58 encoded 8 byte prefix Anchor
+// writes to accounts of the named type.
+func anchorDiscriminator(name string) string {
+	hash := sha256.Sum256([]byte("account:" + name))
+	return base58.Encode(hash[:8])
+}
+
+// fixed decodes an unsigned u128 fixed point number.
+func fixed(b []byte, fractionBits int) float64 {
+	return u128(b) / math.Exp2(float64(fractionBits))
+}
+
+// signedFixed decodes a signed i128 fixed point number.
+func signedFixed(b []byte, fractionBits int) float64 {
+	lo := binary.LittleEndian.Uint64(b[0:8])
+	hi := int64(binary.LittleEndian.Uint64(b[8:16]))
+	return (float64(hi)*math.Exp2(64) + float64(lo)) / math.Exp2(float64(fractionBits))
+}
@@ -0,0 +1,159 @@
+package solana_requests
+
+import (
+	"errors"
+	"fmt"
+	solana_types "solana/types/solana_rpc"
+	"strings"
+)
+
+const (
+	// metaplexKeyMetadataV1 is the account key byte of a Metaplex metadata account.
+	metaplexKeyMetadataV1 = 4
+	// token2022AccountTypeOffset is where Token-2022 stores the account type
+	// byte. Mints are padded up to the size of a token account so both kinds
+	// share one extension layout.
+	token2022AccountTypeOffset = 165
+	token2022AccountTypeMint   = 1
+	// token2022ExtensionTokenMetadata is the TLV type of the metadata extension.
+	token2022ExtensionTokenMetadata = 19
+)
+
+// GetOnChainTokenMetadata reads a mint's name, symbol and uri straight from
+// chain. Token-2022 mints carrying the metadata extension are decoded
+// directly; everything else goes through the Metaplex metadata account.
+func GetOnChainTokenMetadata(mint string) (solana_types.OnChainMetadata, error) {
+	mintAccount, err := RequestRawAccount(mint)
+	if err != nil {
+		return solana_types.OnChainMetadata{}, err
+	}
+	if mintAccount.Owner == Token2022ProgramID {
+		metadata, found, err := decodeToken2022Metadata(mintAccount.Data)
+		if err != nil {
+			return solana_types.OnChainMetadata{}, err
+		}
+		if found {
+			return metadata, nil
+		}
+	}
+	return GetMetaplexMetadata(mint)
+}
+
+// GetMetaplexMetadata fetches and decodes the Metaplex metadata account of a mint.
+func GetMetaplexMetadata(mint string) (solana_types.OnChainMetadata, error) {
+	address, err := MetaplexMetadataAddress(mint)
+	if err != nil {
+		return solana_types.OnChainMetadata{}, err
+	}
+	account, err := RequestRawAccount(address)
+	if err != nil {
+		return solana_types.OnChainMetadata{}, err
+	}
+	if account.Owner != MetaplexMetadataProgramID {
+		return solana_types.OnChainMetadata{}, fmt.Errorf("metadata account %s not owned by the metadata program", address)
+	}
+	return decodeMetaplexMetadata(account.Data)
+}
+
+// MetaplexMetadataAddress derives the metadata PDA of a mint:
+// ["metadata", metadata program id, mint] under the metadata program.
+func MetaplexMetadataAddress(mint string) (string, error) {
+	program, err := DecodePubkey(MetaplexMetadataProgramID)
+	if err != nil {
+		return "", err
+	}
+	mintKey, err := DecodePubkey(mint)
+	if err != nil {
+		return "", err
+	}
+	address, _, err := FindProgramAddress([][]byte{[]byte("metadata"), program, mintKey}, MetaplexMetadataProgramID)
+	return address, err
+}
+
+// decodeMetaplexMetadata decodes the leading fields of a Metaplex metadata
+// account: key, update authority, mint, name, symbol and uri. Strings are
+// stored padded with NUL bytes, which are trimmed.
+func decodeMetaplexMetadata(data []byte) (solana_types.OnChainMetadata, error) {
+	r := newBorshReader(data)
+	if key := r.u8(); r.err == nil && key != metaplexKeyMetadataV1 {
+		return solana_types.OnChainMetadata{}, fmt.Errorf("unexpected metadata account key %d", key)
+	}
+	metadata := solana_types.OnChainMetadata{
+		UpdateAuthority: r.pubkey(),
+		Mint:            r.pubkey(),
+		Name:            trimPadding(r.string()),
+		Symbol:          trimPadding(r.string()),
+		URI:             trimPadding(r.string()),
+	}
+	if r.err != nil {
+		return solana_types.OnChainMetadata{}, r.err
+	}
+	decodeMetaplexCollection(r, &metadata)
+	return metadata, nil
+}
+
+// decodeMetaplexCollection skips ahead to the optional collection field.
+// Accounts created by old program versions end before it, so a short read
+// simply leaves the collection empty.
+func decodeMetaplexCollection(r *borshReader, metadata *solana_types.OnChainMetadata) {
+	r.skip(2) // seller_fee_basis_points
+	if r.u8() == 1 {
+		creators := r.u32()
+		r.skip(int(creators) * 34) // address, verified, share
+	}
+	r.skip(2) // primary_sale_happened, is_mutable
+	if r.u8() == 1 {
+		r.skip(1) // edition_nonce
+	}
+	if r.u8() == 1 {
+		r.skip(1) // token_standard
+	}
+	if r.u8() != 1 {
+		return
+	}
+	verified := r.u8() == 1
+	collection := r.pubkey()
+	if r.err == nil {
+		metadata.Collection = collection
+		metadata.CollectionVerified = verified
+	}
+}
+
+// decodeToken2022Metadata walks the TLV extensions of a Token-2022 mint and
+// decodes the token metadata extension. found is false when the mint has no
+// such extension, e.g. because its metadata pointer targets another account.
+func decodeToken2022Metadata(data []byte) (metadata solana_types.OnChainMetadata, found bool, err error) {
+	if len(data) <= token2022AccountTypeOffset {
+		return metadata, false, nil
+	}
+	if data[token2022AccountTypeOffset] != token2022AccountTypeMint {
+		return metadata, false, errors.New("account is not a Token-2022 mint")
+	}
+	r := newBorshReader(data[token2022AccountTypeOffset+1:])
+	for r.err == nil && r.offset+4 <= len(r.data) {
+		extensionType := r.u16()
+		length := r.u16()
+		value := r.next(int(length))
+		if extensionType == 0 {
+			break
+		}
+		if extensionType != token2022ExtensionTokenMetadata {
+			continue
+		}
+		ext := newBorshReader(value)
+		metadata.UpdateAuthority = ext.pubkey()
+		metadata.Mint = ext.pubkey()
+		metadata.Name = trimPadding(ext.string())
+		metadata.Symbol = trimPadding(ext.string())
+		metadata.URI = trimPadding(ext.string())
+		if ext.err != nil {
+			return solana_types.OnChainMetadata{}, false, ext.err
+		}
+		return metadata, true, nil
+	}
+	return metadata, false, r.err
+}
+
+func trimPadding(s string) string {
+	return strings.TrimSpace(strings.TrimRight(s, "\x00"))
+}
@@ -0,0 +1,65 @@
+package solana_requests
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	solana_types "solana/types/solana_rpc"
+
+	"github.com/mr-tron/base58"
+)
+
+// RequestMintInfo fetches the parsed mint account of a token.
+func RequestMintInfo(mint string) (solana_types.MintAccountValue, error) {
+	data, err := queryRPC("getAccountInfo", []interface{}{
+		mint,
+		map[string]interface{}{
+			"encoding": "jsonParsed",
+		},
+	})
+	if err != nil {
+		return solana_types.MintAccountValue{}, err
+	}
+	var response solana_types.MintAccountResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		return solana_types.MintAccountValue{}, err
+	}
+	if response.Error != nil {
+		return solana_types.MintAccountValue{}, fmt.Errorf("getAccountInfo: %s", response.Error.Message)
+	}
+	if response.Result.Value == nil {
+		return solana_types.MintAccountValue{}, fmt.Errorf("mint %s not found", mint)
+	}
+	if response.Result.Value.Data.Parsed.Type != "mint" {
+		return solana_types.MintAccountValue{}, fmt.Errorf("account %s is not a mint", mint)
+	}
+	return *response.Result.Value, nil
+}
+
+// Mint accounts start with a COption<Pubkey> mint authority (a 4 byte tag
+// and the key), followed by the u64 supply and the u8 decimals. Token-2022
+// mints share this prefix.
+const (
+	mintDecimalsOffset = 44
+	mintBaseSize       = 82
+)
+
+// DecodeMintAuthority returns the mint authority of raw mint data, or an
+// empty string when it has been revoked.
+func DecodeMintAuthority(data []byte) (string, error) {
+	if len(data) < mintBaseSize {
+		return "", errShortBuffer
+	}
+	if binary.LittleEndian.Uint32(data[0:4]) != 1 {
+		return "", nil
+	}
+	return base58.Encode(data[4:36]), nil
+}
+
+// DecodeMintDecimals returns the decimals of raw mint data.
+func DecodeMintDecimals(data []byte) (int, error) {
+	if len(data) < mintBaseSize {
+		return 0, errShortBuffer
+	}
+	return int(data[mintDecimalsOffset]), nil
+}
@@ -0,0 +1,90 @@
+package solana_requests
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	"github.com/mr-tron/base58"
+)
+
+// Curve parameters for ed25519, used to reject seeds that land on the curve.
+var (
+	curveP = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	curveD = func() *big.Int {
+		// d = -121665 / 121666 mod p
+		num := new(big.Int).Neg(big.NewInt(121665))
+		den := new(big.Int).ModInverse(big.NewInt(121666), curveP)
+		d := num.Mul(num, den)
+		return d.Mod(d, curveP)
+	}()
+	legendreExp = new(big.Int).Rsh(new(big.Int).Sub(curveP, big.NewInt(1)), 1)
+)
+
+// FindProgramAddress derives the program derived address for the given seeds
+// the same way the Solana runtime does: the highest bump seed whose hash is
+// not a valid ed25519 point wins.
+func FindProgramAddress(seeds [][]byte, programID string) (string, uint8, error) {
+	program, err := base58.Decode(programID)
+	if err != nil {
+		return "", 0, err
+	}
+	for bump := 255; bump >= 0; bump-- {
+		hasher := sha256.New()
+		for _, seed := range seeds {
+			hasher.Write(seed)
+		}
+		hasher.Write([]byte{byte(bump)})
+		hasher.Write(program)
+		hasher.Write([]byte("ProgramDerivedAddress"))
+		candidate := hasher.Sum(nil)
+		if !isOnCurve(candidate) {
+			return base58.Encode(candidate), uint8(bump), nil
+		}
+	}
+	return "", 0, errors.New("no viable bump seed")
+}
+
+// DecodePubkey decodes a base58 address into its 32 raw bytes.
+func DecodePubkey(address string) ([]byte, error) {
+	decoded, err := base58.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != 32 {
+		return nil, errors.New("invalid address length - must be 32 bytes when decoded")
+	}
+	return decoded, nil
+}
+
+// isOnCurve reports whether the 32 bytes decompress to an ed25519 point,
+// i.e. whether x^2 = (y^2 - 1) / (d*y^2 + 1) has a solution mod p.
+func isOnCurve(point []byte) bool {
+	le := make([]byte, 32)
+	copy(le, point)
+	le[31] &= 0x7f
+	be := make([]byte, 32)
+	for i := range le {
+		be[31-i] = le[i]
+	}
+	y := new(big.Int).SetBytes(be)
+	y.Mod(y, curveP)
+
+	y2 := new(big.Int).Mul(y, y)
+	y2.Mod(y2, curveP)
+	u := new(big.Int).Sub(y2, big.NewInt(1))
+	u.Mod(u, curveP)
+	v := new(big.Int).Mul(curveD, y2)
+	v.Add(v, big.NewInt(1))
+	v.Mod(v, curveP)
+	if v.Sign() == 0 {
+		return false
+	}
+	x2 := new(big.Int).ModInverse(v, curveP)
+	x2.Mul(x2, u)
+	x2.Mod(x2, curveP)
+	if x2.Sign() == 0 {
+		return true
+	}
+	return new(big.Int).Exp(x2, legendreExp, curveP).Cmp(big.NewInt(1)) == 0
+}
@@ -0,0 +1,11 @@
+package solana_requests
+
+// Well-known program ids.
+const (
+	TokenProgramID            = "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA"
+	Token2022ProgramID        = "TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb"
+	MetaplexMetadataProgramID = "metaqbxxUerdq28cj1RbAWkYQm3ybzjb6a8bt518x1s"
+	OrcaWhirlpoolProgramID    = "whirLbMiicVdio4qvUfM5KAg6Ct8VwpYzGff3uctyCc"
+	RaydiumClmmProgramID      = "CAMMCzo5YL8w4VFF8KVHrK22GGUsp5VTaW7grrKgrWqK"
+	MeteoraDlmmProgramID      = "LBUZKhRxPF3XUpBCjp4YzTKgLccjZhTSDM9YuVaPwxo"
+)
@@ -0,0 +1,83 @@
+package solana_requests
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/mr-tron/base58"
+)
+
+const (
+	RaydiumAmmV4ProgramID = "675kPX9MHTjS2zt1qfr1NYHuzeLXfQM9H24wFSUt1Mp8"
+	// RaydiumAmmV4Authority owns the token vaults of every AMM v4 pool.
+	RaydiumAmmV4Authority    = "5Q544fKrFoe6tsEbD7S8EmxGTJYAKtTVhAW5Q5pge4j1"
+	raydiumAmmV4Size         = 752
+	raydiumAmmV4LpMintOffset = 464
+)
+
+// RaydiumAmmPool holds the fields of a Raydium AMM v4 pool account we use.
+type RaydiumAmmPool struct {
+	Address          string
+	BaseVault        string
+	QuoteVault       string
+	BaseMint         string
+	QuoteMint        string
+	LpMint           string
+	BaseDecimals     uint64
+	QuoteDecimals    uint64
+	BaseNeedTakePnl  uint64
+	QuoteNeedTakePnl uint64
+	// LpReserve is the LP supply as tracked by the pool. LP tokens burned
+	// through the token program reduce the mint supply but not this figure.
+	LpReserve uint64
+}
+
+// GetRaydiumAmmPool fetches and decodes a Raydium AMM v4 pool account.
+func GetRaydiumAmmPool(address string) (RaydiumAmmPool, error) {
+	account, err := RequestRawAccount(address)
+	if err != nil {
+		return RaydiumAmmPool{}, err
+	}
+	if account.Owner != RaydiumAmmV4ProgramID {
+		return RaydiumAmmPool{}, fmt.Errorf("account %s is not a Raydium AMM v4 pool", address)
+	}
+	return DecodeRaydiumAmmPool(address, account.Data)
+}
+
+// FindRaydiumAmmPoolByLpMint looks up the AMM v4 pool minting an LP token.
+func FindRaydiumAmmPoolByLpMint(lpMint string) (RaydiumAmmPool, error) {
+	accounts, err := getRawProgramAccounts(RaydiumAmmV4ProgramID,
+		map[string]interface{}{"dataSize": raydiumAmmV4Size},
+		memcmpFilter(raydiumAmmV4LpMintOffset, lpMint),
+	)
+	if err != nil {
+		return RaydiumAmmPool{}, err
+	}
+	if len(accounts) == 0 {
+		return RaydiumAmmPool{}, fmt.Errorf("no Raydium AMM v4 pool mints %s", lpMint)
+	}
+	return DecodeRaydiumAmmPool(accounts[0].Address, accounts[0].Data)
+}
+
+// DecodeRaydiumAmmPool reads the fixed offsets of Raydium's LiquidityStateV4.
+func DecodeRaydiumAmmPool(address string, data []byte) (RaydiumAmmPool, error) {
+	if len(data) < raydiumAmmV4Size {
+		return RaydiumAmmPool{}, errShortBuffer
+	}
+	pubkey := func(offset int) string {
+		return base58.Encode(data[offset : offset+32])
+	}
+	return RaydiumAmmPool{
+		Address:          address,
+		BaseDecimals:     binary.LittleEndian.Uint64(data[32:40]),
+		QuoteDecimals:    binary.LittleEndian.Uint64(data[40:48]),
+		BaseNeedTakePnl:  binary.LittleEndian.Uint64(data[192:200]),
+		QuoteNeedTakePnl: binary.LittleEndian.Uint64(data[200:208]),
+		BaseVault:        pubkey(336),
+		QuoteVault:       pubkey(368),
+		BaseMint:         pubkey(400),
+		QuoteMint:        pubkey(432),
+		LpMint:           pubkey(raydiumAmmV4LpMintOffset),
+		LpReserve:        binary.LittleEndian.Uint64(data[720:728]),
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"strconv"
 
 	"github.com/charmbracelet/log"
@@ -13,6 +14,41 @@ import (
 
 const solanaRPC = "https://api.mainnet-beta.solana.com"
 
+// dasRPC serves the Digital Asset Standard methods (getAsset,
+// getAssetsByOwner), which the public mainnet endpoint does not support.
+// Point SOLANA_DAS_RPC at a DAS-capable provider to enable them.
+var dasRPC = func() string {
+	if url := os.Getenv("SOLANA_DAS_RPC"); url != "" {
+		return url
+	}
+	return solanaRPC
+}()
+
+// queryDAS calls a DAS method. Unlike regular RPC methods, DAS methods take
+// their parameters as a named object.
+func queryDAS(method string, params interface{}) (string, error) {
+	requestPayload := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	}
+	requestBytes, err := json.Marshal(requestPayload)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.Post(dasRPC, "application/json", bytes.NewBuffer(requestBytes))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
 func queryRPC(method string, params []interface{}) (string, error) {
 	requestPayload := map[string]interface{}{
 		"jsonrpc": "2.0",
@@ -33,7 +69,7 @@ func queryRPC(method string, params []interface{}) (string, error) {
 	body, err := ioutil.ReadAll(resp.Body)
 	var prettyJSON bytes.Buffer
 	if err := json.Indent(&prettyJSON, body, "", "  "); err != nil {
-		log.Errorf("Error indenting JSON:", err)
+		log.Error("Error indenting JSON", "Stack", err)
 		return "", err
 	}
 
@@ -79,7 +115,7 @@ func QueryRPCWithRetry(method string, params []interface{}) (string, error) {
 
 	var prettyJSON bytes.Buffer
 	if err := json.Indent(&prettyJSON, body, "", "  "); err != nil {
-		log.Errorf("Error indenting JSON", err)
+		log.Error("Error indenting JSON", "Stack", err)
 		return "", err
 	}
 
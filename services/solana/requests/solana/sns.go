@@ -0,0 +1,143 @@
+package solana_requests
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// Solana Name Service accounts.
+const (
+	NameServiceProgramID = "namesLPneVptA9Z5rqUDD9tMTWEJwofgaYwp8cawRkX"
+	// SolTLDAuthority is the parent of every .sol domain.
+	SolTLDAuthority = "58PwtjSDuFHuUkYjH9BYnnQKHfwo9reZhC2zMJv9JPkx"
+	// ReverseLookupClass is the class of the accounts mapping a domain
+	// account back to its name.
+	ReverseLookupClass = "33m47vH6Eav6jr5Ry86XjhRft2jRBLDnDgPSHoquXi2Z"
+	// NameOffersProgramID stores each wallet's favourite (primary) domain.
+	NameOffersProgramID = "85iDfUvr3HJyLM2LhbhohhYeqLiJ4dEgHXRJQNh5DsUs"
+
+	nameHashPrefix = "SPL Name Service"
+	// Name accounts start with a header of parent name, owner and class.
+	nameOwnerOffset  = 32
+	nameHeaderLength = 96
+)
+
+// ResolveDomain returns the owner of a .sol domain such as "bonfida.sol"
+// or "dex.bonfida.sol".
+func ResolveDomain(domain string) (string, error) {
+	key, err := DomainKey(domain)
+	if err != nil {
+		return "", err
+	}
+	account, err := RequestRawAccount(key)
+	if err != nil {
+		return "", fmt.Errorf("domain %s is not registered: %w", domain, err)
+	}
+	if account.Owner != NameServiceProgramID || len(account.Data) < nameHeaderLength {
+		return "", fmt.Errorf("account %s is not a name account", key)
+	}
+	r := newBorshReader(account.Data[nameOwnerOffset:])
+	return r.pubkey(), nil
+}
+
+// DomainKey derives the name account of a .sol domain. Subdomains are
+// children of their parent domain's account and hashed with a leading
+// zero byte.
+func DomainKey(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".sol")
+	labels := strings.Split(domain, ".")
+	if domain == "" || len(labels) > 2 {
+		return "", fmt.Errorf("invalid domain %q", domain)
+	}
+	key, err := nameAccountKey(labels[len(labels)-1], "", SolTLDAuthority)
+	if err != nil || len(labels) == 1 {
+		return key, err
+	}
+	return nameAccountKey("\x00"+labels[0], "", key)
+}
+
+// ReverseLookup returns the primary .sol domain of each wallet that has set
+// one, keyed by wallet.
+func ReverseLookup(wallets []string) (map[string]string, error) {
+	favourites := make(map[string]string)
+	var favouriteKeys []string
+	for _, wallet := range wallets {
+		owner, err := DecodePubkey(wallet)
+		if err != nil {
+			continue
+		}
+		key, _, err := FindProgramAddress([][]byte{[]byte("favourite_domain"), owner}, NameOffersProgramID)
+		if err != nil {
+			continue
+		}
+		favourites[key] = wallet
+		favouriteKeys = append(favouriteKeys, key)
+	}
+	accounts, err := RequestRawAccounts(favouriteKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	// A favourite domain account holds a tag byte and the domain's name account.
+	reverseOf := make(map[string]string)
+	var reverseKeys []string
+	for key, account := range accounts {
+		r := newBorshReader(account.Data)
+		r.skip(1)
+		nameAccount := r.pubkey()
+		if r.err != nil || account.Owner != NameOffersProgramID {
+			continue
+		}
+		reverseKey, err := reverseLookupKey(nameAccount)
+		if err != nil {
+			continue
+		}
+		reverseOf[reverseKey] = favourites[key]
+		reverseKeys = append(reverseKeys, reverseKey)
+	}
+	reverseAccounts, err := RequestRawAccounts(reverseKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reverse lookup accounts store the domain name after the header.
+	domains := make(map[string]string)
+	for key, account := range reverseAccounts {
+		if account.Owner != NameServiceProgramID || len(account.Data) < nameHeaderLength {
+			continue
+		}
+		r := newBorshReader(account.Data[nameHeaderLength:])
+		name := r.string()
+		if r.err != nil || name == "" {
+			continue
+		}
+		domains[reverseOf[key]] = name + ".sol"
+	}
+	return domains, nil
+}
+
+// reverseLookupKey derives the reverse lookup account of a name account.
+func reverseLookupKey(nameAccount string) (string, error) {
+	return nameAccountKey(nameAccount, ReverseLookupClass, "")
+}
+
+// nameAccountKey derives a name account from the hashed name, its class and
+// its parent. A missing class or parent is seeded as 32 zero bytes.
+func nameAccountKey(name, class, parent string) (string, error) {
+	hashed := sha256.Sum256([]byte(nameHashPrefix + name))
+	seeds := [][]byte{hashed[:]}
+	for _, key := range []string{class, parent} {
+		seed := make([]byte, 32)
+		if key != "" {
+			decoded, err := DecodePubkey(key)
+			if err != nil {
+				return "", err
+			}
+			seed = decoded
+		}
+		seeds = append(seeds, seed)
+	}
+	address, _, err := FindProgramAddress(seeds, NameServiceProgramID)
+	return address, err
+}
@@ -0,0 +1,117 @@
+package solana_requests
+
+import (
+	"encoding/json"
+	"fmt"
+	solana_types "solana/types/solana_rpc"
+)
+
+const StakeProgramID = "Stake11111111111111111111111111111111111111"
+
+// Offsets of the staker and withdrawer authorities in a stake account,
+// after the 4 byte state tag and the 8 byte rent exempt reserve.
+const (
+	stakeStakerOffset     = 12
+	stakeWithdrawerOffset = 44
+)
+
+// GetStakeAccounts returns the stake accounts a wallet controls, either as
+// staker or as withdrawer.
+func GetStakeAccounts(owner string) ([]solana_types.StakeAccount, error) {
+	seen := make(map[string]bool)
+	var accounts []solana_types.StakeAccount
+	for _, offset := range []int{stakeStakerOffset, stakeWithdrawerOffset} {
+		data, err := queryRPC("getProgramAccounts", []interface{}{
+			StakeProgramID,
+			map[string]interface{}{
+				"encoding": "jsonParsed",
+				"filters": []interface{}{
+					map[string]interface{}{
+						"memcmp": map[string]interface{}{
+							"offset": offset,
+							"bytes":  owner,
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var response solana_types.StakeAccountsResponse
+		if err := json.Unmarshal([]byte(data), &response); err != nil {
+			return nil, err
+		}
+		if response.Error != nil {
+			return nil, fmt.Errorf("getProgramAccounts: %s", response.Error.Message)
+		}
+		for _, account := range response.Result {
+			if !seen[account.Pubkey] {
+				seen[account.Pubkey] = true
+				accounts = append(accounts, account)
+			}
+		}
+	}
+	return accounts, nil
+}
+
+// GetEpochInfo returns the current epoch.
+func GetEpochInfo() (solana_types.EpochInfo, error) {
+	data, err := queryRPC("getEpochInfo", []interface{}{})
+	if err != nil {
+		return solana_types.EpochInfo{}, err
+	}
+	var response solana_types.EpochInfoResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		return solana_types.EpochInfo{}, err
+	}
+	if response.Error != nil {
+		return solana_types.EpochInfo{}, fmt.Errorf("getEpochInfo: %s", response.Error.Message)
+	}
+	return response.Result, nil
+}
+
+// GetInflationRewards returns the inflation reward each address earned in
+// an epoch, in the order of addresses. Entries are nil for addresses that
+// earned nothing.
+func GetInflationRewards(addresses []string, epoch uint64) ([]*solana_types.InflationReward, error) {
+	data, err := queryRPC("getInflationReward", []interface{}{
+		addresses,
+		map[string]interface{}{
+			"epoch": epoch,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var response solana_types.InflationRewardResponse
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		return nil, err
+	}
+	if response.Error != nil {
+		return nil, fmt.Errorf("getInflationReward: %s", response.Error.Message)
+	}
+	return response.Result, nil
+}
+
+// GetBlockTime returns the unix time a slot's block was produced.
+func GetBlockTime(slot uint64) (int64, error) {
+	data, err := queryRPC("getBlockTime", []interface{}{slot})
+	if err != nil {
+		return 0, err
+	}
+	var response struct {
+		Result *int64                    `json:"result"`
+		Error  *solana_types.SolanaError `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(data), &response); err != nil {
+		return 0, err
+	}
+	if response.Error != nil {
+		return 0, fmt.Errorf("getBlockTime: %s", response.Error.Message)
+	}
+	if response.Result == nil {
+		return 0, fmt.Errorf("no block time for slot %d", slot)
+	}
+	return *response.Result, nil
+}
@@ -0,0 +1,116 @@
+package solana_requests
+
+import (
+	"fmt"
+)
+
+const (
+	SplStakePoolProgramID = "SPoo1Ku8WFXoNDMHPsrGSTSG1Y47rzgn41SLUNakuHy"
+	// Sanctum deploys the same stake pool program under its own ids.
+	SanctumSingleValidatorProgramID = "SP12tWFxD9oJsVWNavTTBZvMbA6gkAmxtVgxdqvyvhY"
+	SanctumMultiValidatorProgramID  = "SPMBzsVUuoHA4Jm6KunbsotaahvVikZs1JyTW6iJvbn"
+
+	stakePoolAccountType = 1
+)
+
+var stakePoolPrograms = map[string]bool{
+	SplStakePoolProgramID:           true,
+	SanctumSingleValidatorProgramID: true,
+	SanctumMultiValidatorProgramID:  true,
+}
+
+// StakePool holds the fields of an SPL stake pool account we use. Pool
+// tokens and lamports both have 9 decimals, so their ratio is the price of
+// one pool token in SOL.
+type StakePool struct {
+	Address                  string
+	PoolMint                 string
+	TotalLamports            uint64
+	PoolTokenSupply          uint64
+	LastUpdateEpoch          uint64
+	LastEpochTotalLamports   uint64
+	LastEpochPoolTokenSupply uint64
+}
+
+// ExchangeRate returns the SOL backing one pool token.
+func (p StakePool) ExchangeRate() float64 {
+	return ratio(p.TotalLamports, p.PoolTokenSupply)
+}
+
+// LastEpochExchangeRate returns the exchange rate at the previous epoch boundary.
+func (p StakePool) LastEpochExchangeRate() float64 {
+	return ratio(p.LastEpochTotalLamports, p.LastEpochPoolTokenSupply)
+}
+
+func ratio(lamports, supply uint64) float64 {
+	if supply == 0 {
+		return 0
+	}
+	return float64(lamports) / float64(supply)
+}
+
+// GetStakePool fetches and decodes a stake pool account owned by the SPL
+// stake pool program or one of its Sanctum deployments.
+func GetStakePool(address string) (StakePool, error) {
+	account, err := RequestRawAccount(address)
+	if err != nil {
+		return StakePool{}, err
+	}
+	if !stakePoolPrograms[account.Owner] {
+		return StakePool{}, fmt.Errorf("account %s is not a stake pool", address)
+	}
+	return DecodeStakePool(address, account.Data)
+}
+
+// DecodeStakePool decodes the StakePool struct of the SPL stake pool
+// program. Fields up to last_update_epoch sit at fixed offsets; the last
+// epoch totals follow several optional fields and have to be walked to.
+func DecodeStakePool(address string, data []byte) (StakePool, error) {
+	r := newBorshReader(data)
+	if r.u8() != stakePoolAccountType {
+		return StakePool{}, fmt.Errorf("account %s is not an initialized stake pool", address)
+	}
+	// manager, staker, stake_deposit_authority, stake_withdraw_bump_seed,
+	// validator_list, reserve_stake
+	r.skip(32*3 + 1 + 32*2)
+	pool := StakePool{Address: address, PoolMint: r.pubkey()}
+	// manager_fee_account, token_program_id
+	r.skip(32 * 2)
+	pool.TotalLamports = r.u64()
+	pool.PoolTokenSupply = r.u64()
+	pool.LastUpdateEpoch = r.u64()
+	// lockup, epoch_fee
+	r.skip(48 + 16)
+	skipFutureEpochFee(r) // next_epoch_fee
+	skipOptionalPubkey(r) // preferred_deposit_validator_vote_address
+	skipOptionalPubkey(r) // preferred_withdraw_validator_vote_address
+	r.skip(16 + 16)       // stake_deposit_fee, stake_withdrawal_fee
+	skipFutureEpochFee(r) // next_stake_withdrawal_fee
+	r.skip(1)             // stake_referral_fee
+	skipOptionalPubkey(r) // sol_deposit_authority
+	r.skip(16 + 1)        // sol_deposit_fee, sol_referral_fee
+	skipOptionalPubkey(r) // sol_withdraw_authority
+	r.skip(16)            // sol_withdrawal_fee
+	skipFutureEpochFee(r) // next_sol_withdrawal_fee
+	pool.LastEpochPoolTokenSupply = r.u64()
+	pool.LastEpochTotalLamports = r.u64()
+	if r.err != nil {
+		return StakePool{}, r.err
+	}
+	return pool, nil
+}
+
+// skipOptionalPubkey skips a Borsh Option<Pubkey>.
+func skipOptionalPubkey(r *borshReader) {
+	if r.u8() == 1 {
+		r.skip(32)
+	}
+}
+
+// skipFutureEpochFee skips a FutureEpoch<Fee>: a tag of None, One or Two,
+// followed by the 16 byte fee unless None.
+func skipFutureEpochFee(r *borshReader) {
+	if r.u8() != 0 {
+		r.skip(16)
+	}
+}
@@ -11,7 +11,7 @@ func RequestTokenAccounts(address string) (solana_types.TokenAccountsByOwnerResp
 	data, err := queryRPC("getTokenAccountsByOwner", []interface{}{
 		address,
 		map[string]interface{}{
-			"programId": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+			"programId": TokenProgramID,
 		},
 		map[string]interface{}{
 			"encoding": "jsonParsed",
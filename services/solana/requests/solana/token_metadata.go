@@ -2,13 +2,44 @@ package solana_requests
 
 import (
 	"encoding/json"
+	"fmt"
 	solana_types "solana/types/solana_rpc"
 
 	"github.com/charmbracelet/log"
 )
 
+// GetTokenMetadata asks the DAS getAsset method for a token's metadata and
+// falls back to decoding the on-chain metadata when the endpoint does not
+// support DAS or knows no name for the token. The fallback only fills in
+// name, symbol and json_uri.
 func GetTokenMetadata(address string) (solana_types.GetTokenMetaDataResponse, error) {
-	data, err := queryRPC("getAsset", []interface{}{address})
+	response, err := getAsset(address)
+	if err == nil && response.Result.Content.Metadata.Name != "" {
+		return response, nil
+	}
+	onChain, chainErr := GetOnChainTokenMetadata(address)
+	if chainErr != nil {
+		if err != nil {
+			return response, fmt.Errorf("getAsset: %v; on-chain metadata: %w", err, chainErr)
+		}
+		return response, fmt.Errorf("on-chain metadata: %w", chainErr)
+	}
+	response.Result.ID = address
+	response.Result.Content.JSONURI = onChain.URI
+	response.Result.Content.Metadata.Name = onChain.Name
+	response.Result.Content.Metadata.Symbol = onChain.Symbol
+	if onChain.Collection != "" {
+		response.Result.Grouping = []solana_types.Grouping{{
+			GroupKey:   "collection",
+			GroupValue: onChain.Collection,
+			Verified:   &onChain.CollectionVerified,
+		}}
+	}
+	return response, nil
+}
+
+func getAsset(address string) (solana_types.GetTokenMetaDataResponse, error) {
+	data, err := queryDAS("getAsset", map[string]interface{}{"id": address})
 	if err != nil {
 		return solana_types.GetTokenMetaDataResponse{}, err
 	}
@@ -16,6 +47,41 @@ func GetTokenMetadata(address string) (solana_types.GetTokenMetaDataResponse, er
 	err = json.Unmarshal([]byte(data), &response)
 	if err != nil {
 		log.Error("Error occured", "Stack", err)
+		return solana_types.GetTokenMetaDataResponse{}, err
+	}
+	if response.Error != nil {
+		return solana_types.GetTokenMetaDataResponse{}, fmt.Errorf("getAsset: %s", response.Error.Message)
 	}
 	return response, nil
 }
+
+// GetAssetsByOwner pages through all DAS assets of a wallet, including
+// compressed NFTs, with collection metadata attached to their grouping.
+func GetAssetsByOwner(owner string) ([]solana_types.TokenMetaData, error) {
+	const pageSize = 1000
+	var assets []solana_types.TokenMetaData
+	for page := 1; ; page++ {
+		data, err := queryDAS("getAssetsByOwner", map[string]interface{}{
+			"ownerAddress": owner,
+			"page":         page,
+			"limit":        pageSize,
+			"displayOptions": map[string]interface{}{
+				"showCollectionMetadata": true,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var response solana_types.AssetsByOwnerResponse
+		if err := json.Unmarshal([]byte(data), &response); err != nil {
+			return nil, err
+		}
+		if response.Error != nil {
+			return nil, fmt.Errorf("getAssetsByOwner: %s", response.Error.Message)
+		}
+		assets = append(assets, response.Result.Items...)
+		if len(response.Result.Items) < pageSize {
+			return assets, nil
+		}
+	}
+}
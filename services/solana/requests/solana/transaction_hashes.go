@@ -2,23 +2,116 @@ package solana_requests
 
 import (
 	"encoding/json"
+	"fmt"
 	solana_types "solana/types/solana_rpc"
-
-	"github.com/charmbracelet/log"
 )
 
 func GetTransactionHashes(address string) ([]solana_types.WalletTransactionHashResponse, error) {
+	return querySignatures([]interface{}{address})
+}
 
-	data, err := queryRPC("getSignaturesForAddress", []interface{}{address})
+// querySignatures calls getSignaturesForAddress with params. An error
+// object in the response, such as a rate limit, is returned as an error
+// rather than read as an address without signatures.
+func querySignatures(params []interface{}) ([]solana_types.WalletTransactionHashResponse, error) {
+	data, err := queryRPC("getSignaturesForAddress", params)
 	if err != nil {
-
+		return nil, err
 	}
 	var sigResponse solana_types.SignaturesForAddressResponse
 	if err := json.Unmarshal([]byte(data), &sigResponse); err != nil {
-		log.Error("Error unmarshalling getSignaturesForAddress response", "Stack", err)
-		return []solana_types.WalletTransactionHashResponse{}, err
+		return nil, fmt.Errorf("error unmarshalling getSignaturesForAddress response: %w", err)
+	}
+	if sigResponse.Error != nil {
+		return nil, fmt.Errorf("getSignaturesForAddress: %s", sigResponse.Error.Message)
 	}
-
 	return sigResponse.Result, nil
+}
+
+// GetTransactionHashesUntil returns up to limit of an address's signatures
+// newer than until, newest first. Without until it returns the newest ones.
+func GetTransactionHashesUntil(address, until string, limit int) ([]solana_types.WalletTransactionHashResponse, error) {
+	config := map[string]interface{}{"limit": limit}
+	if until != "" {
+		config["until"] = until
+	}
+	return querySignatures([]interface{}{address, config})
+}
 
+// maxSignaturePages bounds how many pages of 1000 signatures
+// GetTransactionHashesSince and GetTransactionHashesAfter walk back through.
+const maxSignaturePages = 10
+
+// GetTransactionHashesAfter returns all of an address's signatures newer
+// than until, newest first, paging backwards with before. Without until it
+// pages back as far as the page limit allows. The second result is false
+// when the page limit was reached before until, so older new signatures are
+// missing.
+func GetTransactionHashesAfter(address, until string) ([]solana_types.WalletTransactionHashResponse, bool, error) {
+	var signatures []solana_types.WalletTransactionHashResponse
+	config := map[string]interface{}{"limit": 1000}
+	if until != "" {
+		config["until"] = until
+	}
+	for page := 0; page < maxSignaturePages; page++ {
+		result, err := querySignatures([]interface{}{address, config})
+		if err != nil {
+			return nil, false, err
+		}
+		signatures = append(signatures, result...)
+		if len(result) < 1000 {
+			return signatures, true, nil
+		}
+		config["before"] = result[len(result)-1].Signature
+	}
+	return signatures, false, nil
+}
+
+// maxSkippedPages bounds how many pages of 1000 signatures newer than the
+// end of a range GetTransactionHashesBetween skips.
+const maxSkippedPages = 20
+
+// GetTransactionHashesSince returns an address's signatures with a block
+// time at or after since (unix seconds), newest first, paging backwards
+// with before. The second result is false when the page limit was reached
+// before since, so older signatures are missing.
+func GetTransactionHashesSince(address string, since int64) ([]solana_types.WalletTransactionHashResponse, bool, error) {
+	return GetTransactionHashesBetween(address, since, 0)
+}
+
+// GetTransactionHashesBetween returns an address's signatures with a block
+// time in [since, until) (unix seconds, until 0 for now), newest first,
+// paging backwards with before. Pages wholly newer than until are skipped
+// without counting towards the page limit, up to maxSkippedPages of them.
+// The second result is false when a limit was reached before since, so
+// signatures in the range are missing.
+func GetTransactionHashesBetween(address string, since, until int64) ([]solana_types.WalletTransactionHashResponse, bool, error) {
+	var signatures []solana_types.WalletTransactionHashResponse
+	config := map[string]interface{}{"limit": 1000}
+	for pages, skipped := 0, 0; pages < maxSignaturePages && skipped < maxSkippedPages; {
+		result, err := querySignatures([]interface{}{address, config})
+		if err != nil {
+			return nil, false, err
+		}
+		inRange := false
+		for _, signature := range result {
+			if signature.BlockTime < since {
+				return signatures, true, nil
+			}
+			if until == 0 || signature.BlockTime < until {
+				signatures = append(signatures, signature)
+				inRange = true
+			}
+		}
+		if len(result) < 1000 {
+			return signatures, true, nil
+		}
+		if inRange {
+			pages++
+		} else {
+			skipped++
+		}
+		config["before"] = result[len(result)-1].Signature
+	}
+	return signatures, false, nil
 }
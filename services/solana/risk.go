@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	solana_requests "solana/requests/solana"
+)
+
+// Weights of the individual risk findings. They add up to the score of a
+// TokenRiskReport, capped at 100.
+const (
+	riskMintAuthority     = 30
+	riskFreezeAuthority   = 20
+	riskPermanentDelegate = 30
+	riskTransferHook      = 10
+	riskTransferFee       = 10
+	riskDefaultFrozen     = 15
+	riskNoPools           = 30
+	riskLowLiquidity      = 20
+	riskThinLiquidity     = 10
+	riskHighConcentration = 20
+	riskConcentration     = 10
+	riskWhale             = 10
+	riskLPNotBurned       = 15
+)
+
+// Thresholds used by the risk checks.
+const (
+	lowLiquidityUSD       = 10_000
+	thinLiquidityUSD      = 50_000
+	highConcentration     = 0.5
+	concentration         = 0.3
+	whaleShare            = 0.2
+	lpBurnedThreshold     = 0.95
+	lpPartiallyBurnedFrom = 0.05
+)
+
+// clmmPrograms are concentrated liquidity programs whose positions are NFTs,
+// so there is no LP mint that could be burned.
+var clmmPrograms = map[string]bool{
+	solana_requests.OrcaWhirlpoolProgramID: true,
+	solana_requests.RaydiumClmmProgramID:   true,
+	solana_requests.MeteoraDlmmProgramID:   true,
+}
+
+// GetTokenRisk checks a token's authorities, Token-2022 extensions, holder
+// concentration, liquidity and LP burn status and scores the findings.
+func (s *server) GetTokenRisk(ctx context.Context, req *pb.TokenRiskRequest) (*pb.TokenRiskReport, error) {
+	if err := validateSolanaAddress(req.Mint); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid mint address: %v", err)
+	}
+	mint, err := solana_requests.RequestMintInfo(req.Mint)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to fetch mint: %v", err)
+	}
+	info := mint.Data.Parsed.Info
+	report := &pb.TokenRiskReport{
+		Mint:         req.Mint,
+		TokenProgram: mint.Owner,
+		Decimals:     int32(info.Decimals),
+		Supply:       uiAmount(info.Supply, info.Decimals),
+	}
+	addRisk := func(weight int32, reason string) {
+		report.Score += weight
+		report.Reasons = append(report.Reasons, reason)
+	}
+
+	// Authorities and extensions.
+	if info.MintAuthority != nil {
+		report.MintAuthority = *info.MintAuthority
+		addRisk(riskMintAuthority, "mint authority not revoked, supply can be inflated")
+	}
+	if info.FreezeAuthority != nil {
+		report.FreezeAuthority = *info.FreezeAuthority
+		addRisk(riskFreezeAuthority, "freeze authority not revoked, holders can be frozen")
+	}
+	for _, ext := range info.Extensions {
+		report.Extensions = append(report.Extensions, ext.Extension)
+		switch ext.Extension {
+		case "permanentDelegate":
+			if delegate, ok := ext.State["delegate"].(string); ok && delegate != "" {
+				report.PermanentDelegate = delegate
+				addRisk(riskPermanentDelegate, "permanent delegate can move or burn any holder's tokens")
+			}
+		case "transferHook":
+			if program, ok := ext.State["programId"].(string); ok && program != "" {
+				addRisk(riskTransferHook, "transfers run a custom hook program")
+			}
+		case "transferFeeConfig":
+			addRisk(riskTransferFee, "transfers are charged a fee")
+		case "defaultAccountState":
+			if state, _ := ext.State["accountState"].(string); state == "frozen" {
+				addRisk(riskDefaultFrozen, "new token accounts start frozen")
+			}
+		}
+	}
+
+	// Liquidity.
+	pools, err := coingecko_requests.RankTokenPools(req.Mint)
+	if err != nil {
+		log.Warn("no pools for token", "mint", req.Mint, "error", err)
+	}
+	poolAddresses := make(map[string]bool)
+	for _, pool := range pools {
+		poolAddresses[pool.Address] = true
+		report.LiquidityUsd += pool.ReserveInUSD
+	}
+	switch {
+	case len(pools) == 0:
+		addRisk(riskNoPools, "no liquidity pools")
+	case report.LiquidityUsd < lowLiquidityUSD:
+		addRisk(riskLowLiquidity, "liquidity below $10k")
+	case report.LiquidityUsd < thinLiquidityUSD:
+		addRisk(riskThinLiquidity, "liquidity below $50k")
+	}
+
+	// Holders.
+	s.assessHolders(report, poolAddresses, addRisk)
+
+	// LP burn status of the deepest pool.
+	report.LpStatus = "unknown"
+	if len(pools) > 0 {
+		report.Pool = pools[0].Address
+		assessLP(report, addRisk)
+	}
+
+	report.Score = min(report.Score, 100)
+	switch {
+	case report.Score < 30:
+		report.Level = "low"
+	case report.Score < 60:
+		report.Level = "medium"
+	default:
+		report.Level = "high"
+	}
+	return report, nil
+}
+
+// assessHolders fills in the largest holders and scores how concentrated
+// the supply is. Pool vaults are reported but not counted as holders.
+func (s *server) assessHolders(report *pb.TokenRiskReport, poolAddresses map[string]bool, addRisk func(int32, string)) {
+	largest, err := solana_requests.GetTokenLargestAccounts(report.Mint)
+	if err != nil {
+		log.Warn("failed to fetch largest holders", "mint", report.Mint, "error", err)
+		return
+	}
+	var tokenAccounts []string
+	for _, account := range largest {
+		tokenAccounts = append(tokenAccounts, account.Address)
+	}
+	owners, err := solana_requests.RequestTokenAccountOwners(tokenAccounts)
+	if err != nil {
+		log.Warn("failed to fetch holder owners", "mint", report.Mint, "error", err)
+	}
+
+	counted := 0
+	for _, account := range largest {
+		owner := owners[account.Address]
+		holder := &pb.TokenHolder{
+			TokenAccount:  account.Address,
+			Owner:         owner,
+			Amount:        account.UIAmount,
+			LiquidityPool: poolAddresses[owner] || owner == solana_requests.RaydiumAmmV4Authority,
+		}
+		if report.Supply > 0 {
+			holder.Share = account.UIAmount / report.Supply
+		}
+		report.TopHolders = append(report.TopHolders, holder)
+		if holder.LiquidityPool || counted >= 10 {
+			continue
+		}
+		counted++
+		report.Top10Share += holder.Share
+	}
+
+	switch {
+	case report.Top10Share > highConcentration:
+		addRisk(riskHighConcentration, "top 10 holders own more than 50% of supply")
+	case report.Top10Share > concentration:
+		addRisk(riskConcentration, "top 10 holders own more than 30% of supply")
+	}
+	for _, holder := range report.TopHolders {
+		if !holder.LiquidityPool && holder.Share > whaleShare {
+			addRisk(riskWhale, "a single holder owns more than 20% of supply")
+			break
+		}
+	}
+}
+
+// assessLP works out how much of the LP supply of report.Pool has been
+// burned. Only Raydium AMM v4 pools can be checked: their pool account keeps
+// the LP reserve, and burned LP tokens lower the mint supply below it.
+func assessLP(report *pb.TokenRiskReport, addRisk func(int32, string)) {
+	account, err := solana_requests.RequestRawAccount(report.Pool)
+	if err != nil {
+		log.Warn("failed to fetch pool account", "pool", report.Pool, "error", err)
+		return
+	}
+	if clmmPrograms[account.Owner] {
+		report.LpStatus = "not applicable"
+		return
+	}
+	if account.Owner != solana_requests.RaydiumAmmV4ProgramID {
+		return
+	}
+	pool, err := solana_requests.DecodeRaydiumAmmPool(report.Pool, account.Data)
+	if err != nil || pool.LpReserve == 0 {
+		return
+	}
+	lpMint, err := solana_requests.RequestMintInfo(pool.LpMint)
+	if err != nil {
+		log.Warn("failed to fetch LP mint", "mint", pool.LpMint, "error", err)
+		return
+	}
+	supply, err := strconv.ParseUint(lpMint.Data.Parsed.Info.Supply, 10, 64)
+	if err != nil {
+		return
+	}
+	burned := 1 - float64(supply)/float64(pool.LpReserve)
+	report.LpBurnedShare = math.Max(0, math.Min(1, burned))
+	switch {
+	case report.LpBurnedShare >= lpBurnedThreshold:
+		report.LpStatus = "burned"
+	case report.LpBurnedShare > lpPartiallyBurnedFrom:
+		report.LpStatus = "partially burned"
+		addRisk(riskLPNotBurned, "LP tokens only partially burned, liquidity can be pulled")
+	default:
+		report.LpStatus = "not burned"
+		addRisk(riskLPNotBurned, "LP tokens not burned, liquidity can be pulled")
+	}
+}
+
+// uiAmount converts a raw integer token amount into token units.
+func uiAmount(raw string, decimals int) float64 {
+	amount, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0
+	}
+	return amount / math.Pow10(decimals)
+}
@@ -0,0 +1,193 @@
+// Package scorecard rates a wallet's trading from its swaps and the lots
+// they disposed of: win rate, hold times, best and worst trades, realised
+// PnL per token, volume per DEX and when the wallet is active.
+package scorecard
+
+import (
+	"sort"
+	"time"
+
+	"solana/taxreport"
+)
+
+// Swap is one swap the wallet made. Volume is the USD value of the larger
+// side.
+type Swap struct {
+	Time   time.Time
+	TxHash string
+	// Dex names the venue, or is empty when it is not known.
+	Dex    string
+	Volume float64
+}
+
+// Trade is a token sold in a swap: the disposals of its lots with a known
+// basis, summed.
+type Trade struct {
+	TxHash   string
+	Time     time.Time
+	Symbol   string
+	Mint     string
+	Proceeds float64
+	Basis    float64
+	Gain     float64
+	// Hold is how long the disposed lots were held, weighted by basis.
+	Hold time.Duration
+}
+
+// Token is the realised result of trading one token.
+type Token struct {
+	Symbol string
+	Mint   string
+	// Trades counts the swaps that sold the token.
+	Trades       int
+	Wins         int
+	Proceeds     float64
+	RealisedGain float64
+}
+
+// Dex is the swaps made on one venue.
+type Dex struct {
+	Name   string
+	Swaps  int
+	Volume float64
+}
+
+// Scorecard rates a wallet's trading over a period. Activity counts
+// transactions by UTC weekday, Sunday first, and hour.
+type Scorecard struct {
+	Swaps        int
+	Volume       float64
+	Trades       []Trade
+	Wins         int
+	Losses       int
+	WinRate      float64
+	AverageHold  time.Duration
+	RealisedGain float64
+	Best, Worst  *Trade
+	Tokens       []Token
+	Dexes        []Dex
+	Activity     [7][24]int
+}
+
+// unknownDex groups swaps on venues that are not known.
+const unknownDex = "other"
+
+// Build rates swaps and the disposals they made. Disposals should leave
+// out the quote asset, such as SOL, so that buying a token is not scored
+// as a trade; disposals with an unknown basis are skipped. activity is the
+// time of every transaction of the wallet, swaps or not.
+func Build(swaps []Swap, disposals []taxreport.Disposal, activity []time.Time) Scorecard {
+	var card Scorecard
+	dexes := make(map[string]*Dex)
+	for _, swap := range swaps {
+		card.Swaps++
+		card.Volume += swap.Volume
+		name := swap.Dex
+		if name == "" {
+			name = unknownDex
+		}
+		dex, ok := dexes[name]
+		if !ok {
+			dex = &Dex{Name: name}
+			dexes[name] = dex
+		}
+		dex.Swaps++
+		dex.Volume += swap.Volume
+	}
+	for _, dex := range dexes {
+		card.Dexes = append(card.Dexes, *dex)
+	}
+	sort.Slice(card.Dexes, func(i, j int) bool {
+		if card.Dexes[i].Volume != card.Dexes[j].Volume {
+			return card.Dexes[i].Volume > card.Dexes[j].Volume
+		}
+		return card.Dexes[i].Name < card.Dexes[j].Name
+	})
+
+	card.Trades = trades(disposals)
+	tokens := make(map[string]*Token)
+	var held time.Duration
+	for i := range card.Trades {
+		trade := &card.Trades[i]
+		card.RealisedGain += trade.Gain
+		win := trade.Gain > 0
+		if win {
+			card.Wins++
+		} else {
+			card.Losses++
+		}
+		held += trade.Hold
+		if card.Best == nil || trade.Gain > card.Best.Gain {
+			card.Best = trade
+		}
+		if card.Worst == nil || trade.Gain < card.Worst.Gain {
+			card.Worst = trade
+		}
+		token, ok := tokens[trade.Mint]
+		if !ok {
+			token = &Token{Symbol: trade.Symbol, Mint: trade.Mint}
+			tokens[trade.Mint] = token
+		}
+		token.Trades++
+		token.Proceeds += trade.Proceeds
+		token.RealisedGain += trade.Gain
+		if win {
+			token.Wins++
+		}
+	}
+	if len(card.Trades) > 0 {
+		card.WinRate = float64(card.Wins) / float64(len(card.Trades))
+		card.AverageHold = held / time.Duration(len(card.Trades))
+	}
+	for _, token := range tokens {
+		card.Tokens = append(card.Tokens, *token)
+	}
+	sort.Slice(card.Tokens, func(i, j int) bool {
+		if card.Tokens[i].RealisedGain != card.Tokens[j].RealisedGain {
+			return card.Tokens[i].RealisedGain > card.Tokens[j].RealisedGain
+		}
+		return card.Tokens[i].Mint < card.Tokens[j].Mint
+	})
+
+	for _, at := range activity {
+		at = at.UTC()
+		card.Activity[at.Weekday()][at.Hour()]++
+	}
+	return card
+}
+
+// trades sums disposals with a known basis per transaction and asset, in
+// the order they were made.
+func trades(disposals []taxreport.Disposal) []Trade {
+	type key struct{ txHash, mint string }
+	index := make(map[key]int)
+	var result []Trade
+	for _, disposal := range disposals {
+		if disposal.BasisUnknown {
+			continue
+		}
+		k := key{disposal.TxHash, disposal.Mint}
+		i, ok := index[k]
+		if !ok {
+			i = len(result)
+			index[k] = i
+			result = append(result, Trade{
+				TxHash: disposal.TxHash,
+				Time:   disposal.Disposed,
+				Symbol: disposal.Symbol,
+				Mint:   disposal.Mint,
+			})
+		}
+		trade := &result[i]
+		hold := disposal.Disposed.Sub(disposal.Acquired)
+		if total := trade.Basis + disposal.Basis; total > 0 {
+			trade.Hold = time.Duration((float64(trade.Hold)*trade.Basis + float64(hold)*disposal.Basis) / total)
+		} else {
+			trade.Hold = hold
+		}
+		trade.Proceeds += disposal.Proceeds
+		trade.Basis += disposal.Basis
+		trade.Gain += disposal.Gain
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+// Package spam flags airdropped scam tokens and dust so they can be hidden
+// before they cost pool, price and metadata lookups.
+package spam
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	coingecko_types "solana/types/coingecko"
+)
+
+// Reasons a token is classified as spam.
+const (
+	ReasonDenyList     = "on deny list"
+	ReasonURLInName    = "url in name"
+	ReasonScamPattern  = "scam name pattern"
+	ReasonNoPools      = "no pools"
+	ReasonNoLiquidity  = "zero liquidity"
+	ReasonDustTemplate = "value below $%.2f"
+)
+
+var (
+	urlPattern  = regexp.MustCompile(`(?i)(https?://|www\.|t\.me/|\.(com|io|net|org|xyz|app|fi|gg|site|online|top|pro|vip|live)\b)`)
+	scamPattern = regexp.MustCompile(`(?i)\b(claim|airdrop|reward|voucher|giveaway|bonus|visit|redeem|free)\b`)
+)
+
+// Classifier checks tokens against a user-maintained deny list and the
+// heuristics below. A nil *Classifier only applies the heuristics.
+type Classifier struct {
+	denied map[string]bool
+}
+
+// NewClassifier returns a classifier with the given denied mints.
+func NewClassifier(denied []string) *Classifier {
+	c := &Classifier{denied: make(map[string]bool, len(denied))}
+	for _, mint := range denied {
+		c.denied[mint] = true
+	}
+	return c
+}
+
+// LoadDenyList reads a deny list file with one mint per line. Blank lines and
+// lines starting with # are ignored, as is anything after the mint.
+func LoadDenyList(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open deny list: %w", err)
+	}
+	defer file.Close()
+	var mints []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		mints = append(mints, strings.Fields(line)[0])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read deny list: %w", err)
+	}
+	return mints, nil
+}
+
+// CheckMint reports whether the mint is on the deny list. It needs no
+// network lookups and runs before anything else.
+func (c *Classifier) CheckMint(mint string) []string {
+	if c != nil && c.denied[mint] {
+		return []string{ReasonDenyList}
+	}
+	return nil
+}
+
+// CheckName looks for links and typical phishing wording in a token's name
+// and symbol.
+func (c *Classifier) CheckName(name, symbol string) []string {
+	var reasons []string
+	text := name + " " + symbol
+	if urlPattern.MatchString(text) {
+		reasons = append(reasons, ReasonURLInName)
+	}
+	if scamPattern.MatchString(text) {
+		reasons = append(reasons, ReasonScamPattern)
+	}
+	return reasons
+}
+
+// CheckPools flags tokens nobody can trade: no pools at all, or only pools
+// without any liquidity.
+func (c *Classifier) CheckPools(pools []coingecko_types.RankedPool) []string {
+	if len(pools) == 0 {
+		return []string{ReasonNoPools}
+	}
+	for _, pool := range pools {
+		if pool.ReserveInUSD > 0 {
+			return nil
+		}
+	}
+	return []string{ReasonNoLiquidity}
+}
+
+// CheckValue flags holdings worth less than minValueUSD. A threshold of 0
+// disables the check.
+func (c *Classifier) CheckValue(valueUSD, minValueUSD float64) []string {
+	if minValueUSD > 0 && valueUSD < minValueUSD {
+		return []string{fmt.Sprintf(ReasonDustTemplate, minValueUSD)}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"strconv"
+
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "solana/generated"
+	solana_requests "solana/requests/solana"
+	solana_types "solana/types/solana_rpc"
+)
+
+const (
+	// defaultRewardEpochs is how many past epochs of rewards are fetched
+	// when the request does not say.
+	defaultRewardEpochs = 5
+	// maxRewardEpochs bounds the epochs a request may ask rewards for, as
+	// each one is a separate RPC call.
+	maxRewardEpochs = 50
+	lamportsPerSol  = 1e9
+	// notDeactivating is the deactivation epoch of stake that was never deactivated.
+	notDeactivating = "18446744073709551615"
+)
+
+// validateRewardEpochs rejects requests for more than maxRewardEpochs
+// epochs of rewards.
+func validateRewardEpochs(rewardEpochs int32) error {
+	if rewardEpochs > maxRewardEpochs {
+		return status.Errorf(codes.InvalidArgument, "reward epochs must be at most %d", maxRewardEpochs)
+	}
+	return nil
+}
+
+// collectStakeAccounts finds the stake accounts controlled by the owners,
+// works out their activation state and fetches their inflation rewards for
+// the last rewardEpochs completed epochs.
+func collectStakeAccounts(owners []string, rewardEpochs int32, solanaPrice float64) []*pb.StakeAccount {
+	seen := make(map[string]bool)
+	var raw []solana_types.StakeAccount
+	for _, owner := range owners {
+		found, err := solana_requests.GetStakeAccounts(owner)
+		if err != nil {
+			log.Error("error fetching stake accounts", "wallet", owner, "error", err)
+			continue
+		}
+		for _, account := range found {
+			if !seen[account.Pubkey] {
+				seen[account.Pubkey] = true
+				raw = append(raw, account)
+			}
+		}
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+
+	epochInfo, err := solana_requests.GetEpochInfo()
+	if err != nil {
+		log.Error("error fetching epoch info", "error", err)
+	}
+	var accounts []*pb.StakeAccount
+	for _, account := range raw {
+		accounts = append(accounts, toStakeAccount(account, epochInfo.Epoch))
+	}
+	if err == nil {
+		if rewardEpochs <= 0 {
+			rewardEpochs = defaultRewardEpochs
+		}
+		addStakeRewards(accounts, epochInfo.Epoch, uint64(rewardEpochs), solanaPrice)
+	}
+	return accounts
+}
+
+// toStakeAccount converts a parsed stake account. Activation and
+// deactivation take effect at the next epoch boundary, so stake is still
+// activating (or deactivating) during the epoch it was (un)delegated in.
+func toStakeAccount(account solana_types.StakeAccount, currentEpoch uint64) *pb.StakeAccount {
+	info := account.Account.Data.Parsed.Info
+	stake := &pb.StakeAccount{
+		Address:    account.Pubkey,
+		Balance:    float64(account.Account.Lamports) / lamportsPerSol,
+		Staker:     info.Meta.Authorized.Staker,
+		Withdrawer: info.Meta.Authorized.Withdrawer,
+		State:      "inactive",
+	}
+	if info.Stake == nil {
+		return stake
+	}
+	delegation := info.Stake.Delegation
+	delegated, _ := strconv.ParseUint(delegation.Stake, 10, 64)
+	stake.Delegated = float64(delegated) / lamportsPerSol
+	stake.Validator = delegation.Voter
+	stake.ActivationEpoch, _ = strconv.ParseUint(delegation.ActivationEpoch, 10, 64)
+	switch {
+	case delegation.DeactivationEpoch != notDeactivating:
+		stake.DeactivationEpoch, _ = strconv.ParseUint(delegation.DeactivationEpoch, 10, 64)
+		if currentEpoch <= stake.DeactivationEpoch {
+			stake.State = "deactivating"
+		}
+	case stake.ActivationEpoch >= currentEpoch:
+		stake.State = "activating"
+	default:
+		stake.State = "active"
+	}
+	return stake
+}
+
+// addStakeRewards fetches the inflation rewards of all accounts for the
+// epochs before currentEpoch, one request per epoch.
+func addStakeRewards(accounts []*pb.StakeAccount, currentEpoch, epochs uint64, solanaPrice float64) {
+	addresses := make([]string, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = account.Address
+	}
+	for epoch := currentEpoch; epoch > 0 && currentEpoch-epoch < epochs; epoch-- {
+		rewards, err := solana_requests.GetInflationRewards(addresses, epoch-1)
+		if err != nil {
+			log.Error("error fetching inflation rewards", "epoch", epoch-1, "error", err)
+			continue
+		}
+		for i, reward := range rewards {
+			if reward == nil || i >= len(accounts) {
+				continue
+			}
+			amount := float64(reward.Amount) / lamportsPerSol
+			stakeReward := &pb.StakeReward{
+				Epoch:       reward.Epoch,
+				Amount:      amount,
+				PostBalance: float64(reward.PostBalance) / lamportsPerSol,
+				Value:       amount * solanaPrice,
+			}
+			if reward.Commission != nil {
+				stakeReward.Commission = int32(*reward.Commission)
+			}
+			accounts[i].Rewards = append(accounts[i].Rewards, stakeReward)
+			accounts[i].TotalRewards += amount
+		}
+	}
+}
+
+// applyStakeAccounts sets the staking totals of a response and adds the
+// staked SOL to its wallet value.
+func applyStakeAccounts(response *pb.WalletResponse, accounts []*pb.StakeAccount, solanaPrice float64) {
+	response.StakeAccounts = accounts
+	for _, account := range accounts {
+		response.StakedSol += account.Balance
+		response.StakingRewards += account.TotalRewards
+	}
+	response.StakedValue = response.StakedSol * solanaPrice
+	response.WalletValue += response.StakedValue
+}
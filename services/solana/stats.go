@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "solana/generated"
+	"solana/income"
+	solana_requests "solana/requests/solana"
+	"solana/scorecard"
+	"solana/taxreport"
+)
+
+// jupiter routes swaps through other venues, so it names a swap that also
+// invokes one of them.
+const jupiter = "Jupiter"
+
+// dexPrograms names the venues of well-known swap programs.
+var dexPrograms = map[string]string{
+	"JUP6LkbZbjS1jKKwapdHNy74zcZ3tLUZoi5QNyVTaV4":  jupiter,
+	"JUP4Fb2cqiRUcaTHdrPC8h2gNsA2ETXiPDD33WcGuJB":  jupiter,
+	solana_requests.RaydiumAmmV4ProgramID:          "Raydium",
+	solana_requests.RaydiumClmmProgramID:           "Raydium",
+	"CPMMoo8L3F4NbTegBCKVNunggL7H1ZpdTHKxQB5qKP1C": "Raydium",
+	solana_requests.OrcaWhirlpoolProgramID:         "Orca",
+	solana_requests.MeteoraDlmmProgramID:           "Meteora",
+	"Eo7WjKq67rjJQSZxS6z3YkapzY3eMj6Xy8X5EQVn5UaB": "Meteora",
+	"6EF8rrecthR5Dkzon8Nwu78hRvfCKubJ14M5uBEwF6P":  "Pump.fun",
+	"pAMMBay6oceH9fJKBRHGP5D4bD4sWpmSwMn52FMfXEA":  "PumpSwap",
+	"PhoeNiXZ8ByJGLkxNfZRnkUfjvmuYqLR89jjFHGqdXY":  "Phoenix",
+}
+
+// GetWalletStats scores each wallet's trading on its own. The transactions
+// in the range are replayed into cost basis lots so that sales are matched
+// against earlier buys in it.
+func (s *server) GetWalletStats(ctx context.Context, req *pb.WalletStatsRequest) (*pb.WalletStatsResponse, error) {
+	wallets, err := s.requestedWallets(ctx, req.WalletAddresses, req.PortfolioId, false)
+	if err != nil {
+		return nil, err
+	}
+	end := time.Now().UTC()
+	start := time.Unix(0, 0).UTC()
+	if req.HistoryRange != "" {
+		window, err := parseHistoryRange(req.HistoryRange)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid history range: %v", err)
+		}
+		start = end.Add(-window)
+	}
+	treatments, err := s.income.Treatments(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	response := &pb.WalletStatsResponse{}
+	for _, wallet := range wallets {
+		stats, err := s.walletStats(ctx, wallet, treatments, start, end)
+		if err != nil {
+			return nil, transactionsError(err)
+		}
+		response.Wallets = append(response.Wallets, stats)
+	}
+	return response, nil
+}
+
+// walletStats scores one wallet's swaps in [start, end).
+func (s *server) walletStats(ctx context.Context, wallet string, treatments income.Treatments, start, end time.Time) (*pb.WalletStats, error) {
+	classified, truncated, err := s.classifyWalletHistory(ctx, []string{wallet}, treatments, start.Unix(), end.Unix())
+	if err != nil {
+		return nil, err
+	}
+	first := start.Unix()
+	if len(classified) > 0 && classified[0].blockTime > first {
+		first = classified[0].blockTime
+	}
+	prices := newPriceHistory(first, end.Unix())
+	var entries []taxreport.Entry
+	var swaps []scorecard.Swap
+	var activity []time.Time
+	swapped := make(map[string]bool)
+	for _, transaction := range classified {
+		entry := s.taxEntry(transaction, prices)
+		entries = append(entries, entry)
+		activity = append(activity, entry.Time)
+		if transaction.kind != taxreport.KindTrade {
+			continue
+		}
+		swapped[transaction.signature] = true
+		swaps = append(swaps, scorecard.Swap{
+			Time:   entry.Time,
+			TxHash: entry.TxHash,
+			Dex:    dexName(transaction.programs),
+			Volume: max(assetsValue(entry.Sent), assetsValue(entry.Received)),
+		})
+	}
+
+	// Only tokens sold in swaps are trades: withdrawals dispose of lots too,
+	// and spending SOL on a token is a buy.
+	summary := taxreport.Gains(entries, nil, start, end, taxreport.DefaultLongTerm)
+	var sales []taxreport.Disposal
+	for _, disposal := range summary.Disposals {
+		if swapped[disposal.TxHash] && disposal.Mint != "" {
+			sales = append(sales, disposal)
+		}
+	}
+	stats := toPbWalletStats(wallet, scorecard.Build(swaps, sales, activity))
+	stats.Truncated = truncated
+	return stats, nil
+}
+
+// dexName names the venue of a swap from the programs it invoked, or
+// returns "" when none is known.
+func dexName(programs []string) string {
+	var found string
+	for _, program := range programs {
+		name, ok := dexPrograms[program]
+		if !ok {
+			continue
+		}
+		if name == jupiter {
+			return name
+		}
+		if found == "" {
+			found = name
+		}
+	}
+	return found
+}
+
+func assetsValue(assets []taxreport.Asset) float64 {
+	var value float64
+	for _, asset := range assets {
+		value += asset.Value
+	}
+	return value
+}
+
+func toPbWalletStats(wallet string, card scorecard.Scorecard) *pb.WalletStats {
+	stats := &pb.WalletStats{
+		Wallet:           wallet,
+		Swaps:            int32(card.Swaps),
+		Volume:           card.Volume,
+		Trades:           int32(len(card.Trades)),
+		Wins:             int32(card.Wins),
+		Losses:           int32(card.Losses),
+		WinRate:          card.WinRate,
+		AverageHoldHours: card.AverageHold.Hours(),
+		RealisedPnl:      card.RealisedGain,
+		BestTrade:        toPbTradeResult(card.Best),
+		WorstTrade:       toPbTradeResult(card.Worst),
+	}
+	for _, token := range card.Tokens {
+		stats.Tokens = append(stats.Tokens, &pb.TokenPnl{
+			Symbol:      token.Symbol,
+			Mint:        token.Mint,
+			Trades:      int32(token.Trades),
+			Wins:        int32(token.Wins),
+			Proceeds:    token.Proceeds,
+			RealisedPnl: token.RealisedGain,
+		})
+	}
+	for _, dex := range card.Dexes {
+		stats.Dexes = append(stats.Dexes, &pb.DexVolume{Name: dex.Name, Swaps: int32(dex.Swaps), Volume: dex.Volume})
+	}
+	for weekday, hours := range card.Activity {
+		for hour, transactions := range hours {
+			if transactions > 0 {
+				stats.Activity = append(stats.Activity, &pb.ActivityCell{
+					Weekday:      int32(weekday),
+					Hour:         int32(hour),
+					Transactions: int32(transactions),
+				})
+			}
+		}
+	}
+	return stats
+}
+
+func toPbTradeResult(trade *scorecard.Trade) *pb.TradeResult {
+	if trade == nil {
+		return nil
+	}
+	return &pb.TradeResult{
+		Signature: trade.TxHash,
+		Time:      trade.Time.UTC().Format(time.RFC3339),
+		Symbol:    trade.Symbol,
+		Mint:      trade.Mint,
+		Proceeds:  trade.Proceeds,
+		Basis:     trade.Basis,
+		Gain:      trade.Gain,
+		HoldHours: trade.Hold.Hours(),
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	"solana/taxreport"
+)
+
+// reportDate is the layout of report start and end dates.
+const reportDate = "2006-01-02"
+
+// ExportTaxReport classifies the owned wallets' transactions in the period,
+// values every leg and fee in USD at the time of the transaction and writes
+// them in the requested CSV format.
+func (s *server) ExportTaxReport(ctx context.Context, req *pb.TaxReportRequest) (*pb.TaxReport, error) {
+	format := strings.ToLower(strings.TrimSpace(req.Format))
+	if !taxreport.ValidFormat(format) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown report format %q", req.Format)
+	}
+	start, end, err := parseReportPeriod(req.StartDate, req.EndDate, time.Now().UTC())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid report period: %v", err)
+	}
+	wallets, err := s.requestedWallets(ctx, req.WalletAddresses, req.PortfolioId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	treatments, err := s.income.Treatments(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	history, truncated, err := s.classifyWalletHistory(ctx, wallets, treatments, start.Unix(), end.Unix())
+	if err != nil {
+		return nil, transactionsError(err)
+	}
+	prices := newPriceHistory(start.Unix(), end.Unix())
+	var entries []taxreport.Entry
+	for _, classified := range history {
+		entries = append(entries, s.taxEntry(classified, prices))
+	}
+
+	var csv bytes.Buffer
+	rows, err := taxreport.Write(&csv, format, entries)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write report: %v", err)
+	}
+	return &pb.TaxReport{
+		Format:    format,
+		Filename:  fmt.Sprintf("pulse-%s-%s-%s.csv", format, start.Format(reportDate), end.AddDate(0, 0, -1).Format(reportDate)),
+		Csv:       csv.Bytes(),
+		Rows:      int32(rows),
+		Truncated: truncated,
+	}, nil
+}
+
+// parseReportPeriod returns the start of the first day and the end of the
+// last day of a report period.
+func parseReportPeriod(startDate, endDate string, now time.Time) (time.Time, time.Time, error) {
+	start := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	var err error
+	if startDate != "" {
+		if start, err = time.Parse(reportDate, startDate); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if endDate != "" {
+		if end, err = time.Parse(reportDate, endDate); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	end = end.AddDate(0, 0, 1)
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("start %s is after end %s", startDate, endDate)
+	}
+	return start, end, nil
+}
+
+// taxEntry values a classified transaction's legs and fee at the time it
+// happened. Spam is worth nothing.
+func (s *server) taxEntry(classified classifiedTransaction, prices *priceHistory) taxreport.Entry {
+	entry := taxreport.Entry{
+		Time:         time.Unix(classified.blockTime, 0).UTC(),
+		Kind:         classified.kind,
+		Wallet:       classified.wallet,
+		Counterparty: classified.counterparty,
+		TxHash:       classified.signature,
+	}
+	asset := func(t transfer) taxreport.Asset {
+		asset := taxreport.Asset{Symbol: s.assetSymbol(t.mint), Mint: t.mint, Amount: t.amount}
+		if classified.kind != taxreport.KindSpam {
+			asset.Value = t.amount * prices.price(t.mint, classified.blockTime)
+		}
+		return asset
+	}
+	for _, t := range classified.sent {
+		entry.Sent = append(entry.Sent, asset(t))
+	}
+	for _, t := range classified.received {
+		entry.Received = append(entry.Received, asset(t))
+	}
+	if classified.fee > 0 {
+		entry.Fee = asset(transfer{amount: classified.fee})
+	}
+	if classified.kind == taxreport.KindTransfer {
+		direction := "to"
+		if len(classified.received) > 0 {
+			direction = "from"
+		}
+		entry.Description = fmt.Sprintf("transfer %s own wallet %s", direction, classified.counterparty)
+	}
+	return entry
+}
+
+// priceHistory prices assets at past times from candles fetched once per
+// mint for the whole period: hourly, or daily for periods over a year.
+type priceHistory struct {
+	resolution coingecko_requests.Resolution
+	start, end int64
+	candles    map[string][]*pb.PricePoint
+}
+
+func newPriceHistory(start, end int64) *priceHistory {
+	resolution, _ := coingecko_requests.ParseResolution("1h")
+	if time.Duration(end-start)*time.Second > 365*24*time.Hour {
+		resolution, _ = coingecko_requests.ParseResolution("1d")
+	}
+	return &priceHistory{resolution: resolution, start: start, end: end, candles: make(map[string][]*pb.PricePoint)}
+}
+
+// price returns a mint's USD price at a unix time, or 0 when it has no
+// pool. Wrapped SOL shares SOL's candles.
+func (p *priceHistory) price(mint string, at int64) float64 {
+	if mint == wrappedSolMint {
+		mint = ""
+	}
+	candles, ok := p.candles[mint]
+	if !ok {
+		candles = historyPrices(mint, p.resolution, p.start-p.resolution.Seconds(), p.end)
+		p.candles[mint] = candles
+	}
+	return getNearestOHLCVPrice(candles, int32(at))
+}
@@ -0,0 +1,291 @@
+package taxreport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// Income kinds. Airdrops and other income share their entry kind.
+const (
+	IncomeStakingReward = "staking_reward"
+	IncomeAirdrop       = KindAirdrop
+	IncomeOther         = KindIncome
+)
+
+// DefaultLongTerm is how long an asset must be held for its disposal to be
+// long term when the caller does not say: more than a year.
+const DefaultLongTerm = 365 * 24 * time.Hour
+
+// Income is an asset received as income, valued at receipt. Source is the
+// stake account of a staking reward or the wallet that received an airdrop.
+type Income struct {
+	Time   time.Time
+	Kind   string
+	Asset  Asset
+	Source string
+	TxHash string
+}
+
+// Disposal is the disposal of all or part of one acquired lot.
+type Disposal struct {
+	Symbol   string
+	Mint     string
+	Amount   float64
+	Acquired time.Time
+	Disposed time.Time
+	Proceeds float64
+	Basis    float64
+	Gain     float64
+	LongTerm bool
+	// BasisUnknown marks amounts disposed of beyond the acquisitions in the
+	// history. Their basis is 0 and Acquired is zero.
+	BasisUnknown bool
+	TxHash       string
+}
+
+// HoldingDays returns the whole days the disposed lot was held.
+func (d Disposal) HoldingDays() int {
+	if d.BasisUnknown {
+		return 0
+	}
+	return int(d.Disposed.Sub(d.Acquired) / (24 * time.Hour))
+}
+
+// Summary is a tax year's disposals and income and their totals.
+type Summary struct {
+	TaxYear       int
+	Start, End    time.Time
+	Disposals     []Disposal
+	Income        []Income
+	Proceeds      float64
+	Basis         float64
+	ShortTermGain float64
+	LongTermGain  float64
+	TotalIncome   float64
+	// Fees is the value of the network fees paid in the year. Their SOL is
+	// taken from its lots but not reported as disposals.
+	Fees float64
+}
+
+// EntryIncome returns the priced assets received in airdrop and income
+// entries as income, credited to the receiving wallet.
+func EntryIncome(entries []Entry) []Income {
+	var income []Income
+	for _, entry := range entries {
+		if entry.Kind != KindAirdrop && entry.Kind != KindIncome {
+			continue
+		}
+		for _, received := range entry.Received {
+			if received.Value <= 0 {
+				continue
+			}
+			income = append(income, Income{
+				Time:   entry.Time,
+				Kind:   entry.Kind,
+				Asset:  received,
+				Source: entry.Wallet,
+				TxHash: entry.TxHash,
+			})
+		}
+	}
+	return income
+}
+
+// lot is an acquired amount of an asset not yet disposed of.
+type lot struct {
+	amount   float64
+	basis    float64
+	acquired time.Time
+}
+
+// Gains matches every disposal in entries against the earliest remaining
+// acquisition of the same asset, first in first out, and summarises the
+// disposals and income in [start, end). Lots are pooled across the
+// reporting wallets, so transfers between them neither acquire nor dispose.
+// Received assets are acquired at their value at receipt, which is zero
+// for spam; sent assets are disposed of for theirs. Network fees are paid
+// from the earliest SOL lots, basis and all, but are not reported as
+// disposals; Fees totals them instead.
+// Entries must be in time order.
+func Gains(entries []Entry, income []Income, start, end time.Time, longTerm time.Duration) Summary {
+	summary := Summary{TaxYear: start.Year(), Start: start, End: end}
+	lots := make(map[string][]lot)
+	for _, entry := range entries {
+		reported := !entry.Time.Before(start) && entry.Time.Before(end)
+		if entry.Fee.Amount > 0 {
+			spend(lots, entry.Fee)
+			if reported {
+				summary.Fees += entry.Fee.Value
+			}
+		}
+		if entry.Kind == KindTransfer || entry.Kind == KindFee {
+			continue
+		}
+		for _, sent := range entry.Sent {
+			disposals := dispose(lots, sent, entry.Time, longTerm)
+			if !reported {
+				continue
+			}
+			for _, disposal := range disposals {
+				disposal.TxHash = entry.TxHash
+				summary.add(disposal)
+			}
+		}
+		for _, received := range entry.Received {
+			lots[received.Mint] = append(lots[received.Mint], lot{
+				amount:   received.Amount,
+				basis:    received.Value,
+				acquired: entry.Time,
+			})
+		}
+	}
+	for _, item := range income {
+		if !item.Time.Before(start) && item.Time.Before(end) {
+			summary.Income = append(summary.Income, item)
+			summary.TotalIncome += item.Asset.Value
+		}
+	}
+	sort.SliceStable(summary.Income, func(i, j int) bool {
+		return summary.Income[i].Time.Before(summary.Income[j].Time)
+	})
+	return summary
+}
+
+// dispose removes sold from the earliest lots and returns one disposal per
+// lot consumed, with the proceeds split by amount. Whatever the lots do not
+// cover is disposed of with an unknown basis.
+func dispose(lots map[string][]lot, sold Asset, at time.Time, longTerm time.Duration) []Disposal {
+	var disposals []Disposal
+	remaining := sold.Amount
+	queue := lots[sold.Mint]
+	newDisposal := func(amount float64) Disposal {
+		disposal := Disposal{Symbol: sold.Symbol, Mint: sold.Mint, Amount: amount, Disposed: at}
+		if sold.Amount > 0 {
+			disposal.Proceeds = sold.Value * amount / sold.Amount
+		}
+		return disposal
+	}
+	for remaining > 0 && len(queue) > 0 {
+		current := &queue[0]
+		amount := min(remaining, current.amount)
+		basis := current.basis * amount / current.amount
+		disposal := newDisposal(amount)
+		disposal.Acquired = current.acquired
+		disposal.Basis = basis
+		disposal.LongTerm = at.Sub(current.acquired) > longTerm
+		disposal.Gain = disposal.Proceeds - basis
+		disposals = append(disposals, disposal)
+
+		current.amount -= amount
+		current.basis -= basis
+		remaining -= amount
+		if current.amount <= 0 {
+			queue = queue[1:]
+		}
+	}
+	lots[sold.Mint] = queue
+	if remaining > 0 {
+		disposal := newDisposal(remaining)
+		disposal.BasisUnknown = true
+		disposal.Gain = disposal.Proceeds
+		disposals = append(disposals, disposal)
+	}
+	return disposals
+}
+
+// spend removes an amount paid away, such as a fee, from the earliest lots
+// without disposing of it.
+func spend(lots map[string][]lot, paid Asset) {
+	remaining := paid.Amount
+	queue := lots[paid.Mint]
+	for remaining > 0 && len(queue) > 0 {
+		current := &queue[0]
+		amount := min(remaining, current.amount)
+		current.basis -= current.basis * amount / current.amount
+		current.amount -= amount
+		remaining -= amount
+		if current.amount <= 0 {
+			queue = queue[1:]
+		}
+	}
+	lots[paid.Mint] = queue
+}
+
+func (s *Summary) add(disposal Disposal) {
+	s.Disposals = append(s.Disposals, disposal)
+	s.Proceeds += disposal.Proceeds
+	s.Basis += disposal.Basis
+	if disposal.LongTerm {
+		s.LongTermGain += disposal.Gain
+	} else {
+		s.ShortTermGain += disposal.Gain
+	}
+}
+
+// WriteGainsCSV writes a summary's disposals and income as CSV, one line
+// each, distinguished by the first column.
+func WriteGainsCSV(w io.Writer, summary Summary) error {
+	out := csv.NewWriter(w)
+	lines := [][]string{{"type", "date_acquired", "date", "symbol", "mint", "amount",
+		"holding_days", "term", "proceeds_usd", "basis_usd", "gain_usd", "income_kind", "source", "tx_hash"}}
+	for _, d := range summary.Disposals {
+		acquired := ""
+		if !d.BasisUnknown {
+			acquired = d.Acquired.UTC().Format(time.RFC3339)
+		}
+		lines = append(lines, []string{"disposal", acquired, d.Disposed.UTC().Format(time.RFC3339),
+			d.Symbol, d.Mint, formatAmount(d.Amount), strconv.Itoa(d.HoldingDays()), term(d),
+			formatValue(d.Proceeds), formatValue(d.Basis), formatValue(d.Gain), "", "", d.TxHash})
+	}
+	for _, item := range summary.Income {
+		lines = append(lines, []string{"income", "", item.Time.UTC().Format(time.RFC3339),
+			item.Asset.Symbol, item.Asset.Mint, formatAmount(item.Asset.Amount), "", "",
+			formatValue(item.Asset.Value), "", "", item.Kind, item.Source, item.TxHash})
+	}
+	return out.WriteAll(lines)
+}
+
+// WriteGainsText writes a summary as a plain text report: totals, then the
+// disposals and income in aligned columns.
+func WriteGainsText(w io.Writer, summary Summary) error {
+	out := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(out, "Capital gains report, tax year %d (%s to %s)\n\n", summary.TaxYear,
+		summary.Start.Format("2006-01-02"), summary.End.Add(-time.Second).Format("2006-01-02"))
+	fmt.Fprintf(out, "Proceeds\t%s\t\n", formatValue(summary.Proceeds))
+	fmt.Fprintf(out, "Cost basis\t%s\t\n", formatValue(summary.Basis))
+	fmt.Fprintf(out, "Short-term gain\t%s\t\n", formatValue(summary.ShortTermGain))
+	fmt.Fprintf(out, "Long-term gain\t%s\t\n", formatValue(summary.LongTermGain))
+	fmt.Fprintf(out, "Income\t%s\t\n", formatValue(summary.TotalIncome))
+	fmt.Fprintf(out, "Network fees (paid from SOL lots, not disposals)\t%s\t\n\n", formatValue(summary.Fees))
+
+	fmt.Fprintf(out, "Disposals\n")
+	fmt.Fprintf(out, "Acquired\tDisposed\tAsset\tAmount\tDays\tTerm\tProceeds\tBasis\tGain\t\n")
+	for _, d := range summary.Disposals {
+		acquired := "unknown"
+		if !d.BasisUnknown {
+			acquired = d.Acquired.UTC().Format("2006-01-02")
+		}
+		fmt.Fprintf(out, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\t%s\t\n", acquired, d.Disposed.UTC().Format("2006-01-02"),
+			d.Symbol, formatAmount(d.Amount), d.HoldingDays(), term(d),
+			formatValue(d.Proceeds), formatValue(d.Basis), formatValue(d.Gain))
+	}
+	fmt.Fprintf(out, "\nIncome\n")
+	fmt.Fprintf(out, "Received\tKind\tAsset\tAmount\tValue\t\n")
+	for _, item := range summary.Income {
+		fmt.Fprintf(out, "%s\t%s\t%s\t%s\t%s\t\n", item.Time.UTC().Format("2006-01-02"), item.Kind,
+			item.Asset.Symbol, formatAmount(item.Asset.Amount), formatValue(item.Asset.Value))
+	}
+	return out.Flush()
+}
+
+func term(d Disposal) string {
+	if d.LongTerm {
+		return "long"
+	}
+	return "short"
+}
@@ -0,0 +1,199 @@
+package taxreport
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+const (
+	usdc = "EPjFWdd5AufqSSqeM2qA1w4NjDLpRK3PamvT5PcpYhR"
+	bonk = "DezXAZ8z7PnrnRJjz3wXBoRgixCa6xjnB7YaB1pPB263"
+)
+
+func day(n int) time.Time {
+	return time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).AddDate(0, 0, n)
+}
+
+// buy acquires a lot. Nothing is sent for it, so the only disposals are
+// the ones under test.
+func buy(at time.Time, mint string, amount, value float64) Entry {
+	return Entry{
+		Time:     at,
+		Kind:     KindDeposit,
+		Received: []Asset{{Symbol: "BONK", Mint: mint, Amount: amount, Value: value}},
+		TxHash:   "buy-" + at.Format("0102"),
+	}
+}
+
+func sell(at time.Time, mint string, amount, value float64) Entry {
+	return Entry{
+		Time:     at,
+		Kind:     KindTrade,
+		Sent:     []Asset{{Symbol: "BONK", Mint: mint, Amount: amount, Value: value}},
+		Received: []Asset{{Symbol: "USDC", Mint: usdc, Amount: value, Value: value}},
+		TxHash:   "sell-" + at.Format("0102"),
+	}
+}
+
+// disposal is the part of a Disposal the tests check.
+type disposal struct {
+	amount, proceeds, basis float64
+	acquired                time.Time
+	longTerm, unknown       bool
+}
+
+func TestGains(t *testing.T) {
+	year := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		entries   []Entry
+		want      []disposal
+		wantFees  float64
+		wantShort float64
+		wantLong  float64
+	}{
+		{
+			name: "earliest lot first",
+			entries: []Entry{
+				buy(day(0), bonk, 100, 100),
+				buy(day(1), bonk, 100, 200),
+				sell(day(2), bonk, 100, 300),
+			},
+			want: []disposal{
+				{amount: 100, proceeds: 300, basis: 100, acquired: day(0)},
+			},
+			wantShort: 200,
+		},
+		{
+			name: "sale spanning lots splits proceeds by amount",
+			entries: []Entry{
+				buy(day(0), bonk, 100, 100),
+				buy(day(1), bonk, 100, 200),
+				sell(day(2), bonk, 150, 300),
+			},
+			want: []disposal{
+				{amount: 100, proceeds: 200, basis: 100, acquired: day(0)},
+				{amount: 50, proceeds: 100, basis: 100, acquired: day(1)},
+			},
+			wantShort: 100,
+		},
+		{
+			name: "partial lot keeps the rest of its basis",
+			entries: []Entry{
+				buy(day(0), bonk, 100, 100),
+				sell(day(1), bonk, 25, 50),
+				sell(day(2), bonk, 75, 60),
+			},
+			want: []disposal{
+				{amount: 25, proceeds: 50, basis: 25, acquired: day(0)},
+				{amount: 75, proceeds: 60, basis: 75, acquired: day(0)},
+			},
+			wantShort: 10,
+		},
+		{
+			name: "long term after the holding period",
+			entries: []Entry{
+				buy(day(-400), bonk, 100, 100),
+				buy(day(-10), bonk, 100, 100),
+				sell(day(1), bonk, 200, 400),
+			},
+			want: []disposal{
+				{amount: 100, proceeds: 200, basis: 100, acquired: day(-400), longTerm: true},
+				{amount: 100, proceeds: 200, basis: 100, acquired: day(-10)},
+			},
+			wantShort: 100,
+			wantLong:  100,
+		},
+		{
+			name: "exactly the holding period is short term",
+			entries: []Entry{
+				buy(day(0), bonk, 1, 10),
+				sell(day(365), bonk, 1, 30),
+			},
+			want: []disposal{
+				{amount: 1, proceeds: 30, basis: 10, acquired: day(0)},
+			},
+			wantShort: 20,
+		},
+		{
+			name: "sale beyond acquisitions has unknown basis",
+			entries: []Entry{
+				buy(day(0), bonk, 50, 50),
+				sell(day(1), bonk, 100, 200),
+			},
+			want: []disposal{
+				{amount: 50, proceeds: 100, basis: 50, acquired: day(0)},
+				{amount: 50, proceeds: 100, unknown: true},
+			},
+			wantShort: 150,
+		},
+		{
+			name: "disposals before the year consume lots unreported",
+			entries: []Entry{
+				buy(day(-30), bonk, 100, 100),
+				buy(day(-20), bonk, 100, 300),
+				sell(day(-10), bonk, 100, 150),
+				sell(day(1), bonk, 100, 250),
+			},
+			want: []disposal{
+				{amount: 100, proceeds: 250, basis: 300, acquired: day(-20)},
+			},
+			wantShort: -50,
+		},
+		{
+			name: "transfers between own wallets neither acquire nor dispose",
+			entries: []Entry{
+				buy(day(0), bonk, 100, 100),
+				{Time: day(1), Kind: KindTransfer, Sent: []Asset{{Mint: bonk, Amount: 100, Value: 500}}},
+				{Time: day(1), Kind: KindTransfer, Received: []Asset{{Mint: bonk, Amount: 100, Value: 500}}},
+				sell(day(2), bonk, 100, 500),
+			},
+			want: []disposal{
+				{amount: 100, proceeds: 500, basis: 100, acquired: day(0)},
+			},
+			wantShort: 400,
+		},
+		{
+			name: "fees are paid from the earliest SOL lots",
+			entries: []Entry{
+				{Time: day(0), Kind: KindDeposit, Received: []Asset{{Symbol: "SOL", Amount: 10, Value: 1000}}},
+				{Time: day(1), Kind: KindFee, Fee: Asset{Symbol: "SOL", Amount: 1, Value: 120}},
+				{Time: day(2), Kind: KindWithdrawal, Sent: []Asset{{Symbol: "SOL", Amount: 9, Value: 1080}}},
+			},
+			want: []disposal{
+				{amount: 9, proceeds: 1080, basis: 900, acquired: day(0)},
+			},
+			wantFees:  120,
+			wantShort: 180,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			summary := Gains(test.entries, nil, year, year.AddDate(1, 0, 0), DefaultLongTerm)
+			if len(summary.Disposals) != len(test.want) {
+				t.Fatalf("got %d disposals, want %d: %+v", len(summary.Disposals), len(test.want), summary.Disposals)
+			}
+			for i, want := range test.want {
+				got := summary.Disposals[i]
+				if !near(got.Amount, want.amount) || !near(got.Proceeds, want.proceeds) || !near(got.Basis, want.basis) ||
+					!got.Acquired.Equal(want.acquired) || got.LongTerm != want.longTerm || got.BasisUnknown != want.unknown {
+					t.Errorf("disposal %d = %+v, want %+v", i, got, want)
+				}
+				if !near(got.Gain, got.Proceeds-got.Basis) {
+					t.Errorf("disposal %d gain = %v, want proceeds less basis", i, got.Gain)
+				}
+			}
+			if !near(summary.ShortTermGain, test.wantShort) || !near(summary.LongTermGain, test.wantLong) {
+				t.Errorf("gains = %v short, %v long; want %v, %v", summary.ShortTermGain, summary.LongTermGain, test.wantShort, test.wantLong)
+			}
+			if !near(summary.Fees, test.wantFees) {
+				t.Errorf("fees = %v, want %v", summary.Fees, test.wantFees)
+			}
+		})
+	}
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
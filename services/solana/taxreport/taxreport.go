@@ -0,0 +1,250 @@
+// Package taxreport writes classified wallet transactions as CSV in the
+// import formats of Koinly and CoinTracker, and as a generic ledger with one
+// row per asset movement, and computes capital gains and income per tax
+// year.
+package taxreport
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Report formats.
+const (
+	FormatKoinly      = "koinly"
+	FormatCoinTracker = "cointracker"
+	FormatLedger      = "ledger"
+)
+
+// ValidFormat reports whether format is a known report format.
+func ValidFormat(format string) bool {
+	switch format {
+	case FormatKoinly, FormatCoinTracker, FormatLedger:
+		return true
+	}
+	return false
+}
+
+// Entry kinds. A trade both sends and receives assets; a deposit only
+// receives and a withdrawal only sends; a transfer moves assets between the
+// reporting wallets; a fee entry only paid the network fee. Tokens received
+// without sending anything are airdrops, unless the user marked them as
+// other income, a gift or spam.
+const (
+	KindTrade      = "trade"
+	KindDeposit    = "deposit"
+	KindWithdrawal = "withdrawal"
+	KindTransfer   = "transfer"
+	KindFee        = "fee"
+	KindAirdrop    = "airdrop"
+	KindIncome     = "income"
+	KindGift       = "gift"
+	KindSpam       = "spam"
+)
+
+// Asset is an amount of one asset and its USD value at the time.
+type Asset struct {
+	Symbol string
+	Mint   string
+	Amount float64
+	Value  float64
+}
+
+// Entry is one wallet's side of a transaction.
+type Entry struct {
+	Time     time.Time
+	Kind     string
+	Wallet   string
+	Sent     []Asset
+	Received []Asset
+	// Fee is the network fee the wallet paid, if any.
+	Fee          Asset
+	Counterparty string
+	TxHash       string
+	Description  string
+}
+
+// row is one line of a Koinly or CoinTracker report: at most one sent and
+// one received asset.
+type row struct {
+	entry    *Entry
+	sent     *Asset
+	received *Asset
+	fee      *Asset
+}
+
+// Write writes entries in format and returns the number of rows written,
+// not counting the header.
+func Write(w io.Writer, format string, entries []Entry) (int, error) {
+	out := csv.NewWriter(w)
+	var header []string
+	var lines [][]string
+	switch format {
+	case FormatKoinly:
+		header = []string{"Date", "Sent Amount", "Sent Currency", "Received Amount", "Received Currency",
+			"Fee Amount", "Fee Currency", "Net Worth Amount", "Net Worth Currency", "Label", "Description", "TxHash"}
+		for _, r := range rows(entries) {
+			lines = append(lines, koinlyLine(r))
+		}
+	case FormatCoinTracker:
+		header = []string{"Date", "Received Quantity", "Received Currency", "Sent Quantity", "Sent Currency",
+			"Fee Amount", "Fee Currency", "Tag"}
+		for _, r := range rows(entries) {
+			lines = append(lines, coinTrackerLine(r))
+		}
+	case FormatLedger:
+		header = []string{"date", "tx_hash", "wallet", "kind", "direction", "symbol", "mint",
+			"amount", "value_usd", "counterparty", "description"}
+		lines = ledgerLines(entries)
+	default:
+		return 0, fmt.Errorf("unknown report format %q", format)
+	}
+	if err := out.Write(header); err != nil {
+		return 0, err
+	}
+	if err := out.WriteAll(lines); err != nil {
+		return 0, err
+	}
+	return len(lines), nil
+}
+
+// rows splits entries into rows of one sent and one received asset. A
+// trade pairs its legs in order and reports unpaired legs as their own
+// rows; the fee goes on an entry's first row. Spam is left out; the tax
+// software would otherwise price it.
+func rows(entries []Entry) []row {
+	var result []row
+	for i := range entries {
+		entry := &entries[i]
+		if entry.Kind == KindSpam {
+			continue
+		}
+		var entryRows []row
+		n := max(len(entry.Sent), len(entry.Received))
+		for j := 0; j < n; j++ {
+			r := row{entry: entry}
+			if j < len(entry.Sent) {
+				r.sent = &entry.Sent[j]
+			}
+			if j < len(entry.Received) {
+				r.received = &entry.Received[j]
+			}
+			entryRows = append(entryRows, r)
+		}
+		if entry.Fee.Amount > 0 {
+			if len(entryRows) == 0 {
+				// Paying only the fee is reported as sending it.
+				entryRows = append(entryRows, row{entry: entry, sent: &entry.Fee})
+			} else {
+				entryRows[0].fee = &entry.Fee
+			}
+		}
+		result = append(result, entryRows...)
+	}
+	return result
+}
+
+func koinlyLine(r row) []string {
+	line := make([]string, 12)
+	line[0] = r.entry.Time.UTC().Format("2006-01-02 15:04:05 UTC")
+	line[1], line[2] = assetColumns(r.sent)
+	line[3], line[4] = assetColumns(r.received)
+	line[5], line[6] = assetColumns(r.fee)
+	if worth := netWorth(r); worth > 0 {
+		line[7], line[8] = formatValue(worth), "USD"
+	}
+	line[9] = koinlyLabel(r)
+	line[10] = r.entry.Description
+	line[11] = r.entry.TxHash
+	return line
+}
+
+// koinlyLabel tags fee-only rows as costs and received income and gifts.
+// Koinly infers trades, deposits and withdrawals from the columns set, and
+// matches transfers between imported wallets itself.
+func koinlyLabel(r row) string {
+	switch r.entry.Kind {
+	case KindFee:
+		return "cost"
+	case KindAirdrop, KindIncome, KindGift:
+		return r.entry.Kind
+	}
+	return ""
+}
+
+func coinTrackerLine(r row) []string {
+	line := make([]string, 8)
+	line[0] = r.entry.Time.UTC().Format("01/02/2006 15:04:05")
+	line[1], line[2] = assetColumns(r.received)
+	line[3], line[4] = assetColumns(r.sent)
+	line[5], line[6] = assetColumns(r.fee)
+	switch r.entry.Kind {
+	case KindAirdrop, KindIncome, KindGift:
+		line[7] = r.entry.Kind
+	}
+	return line
+}
+
+// ledgerLines writes every asset movement, fees included, as its own line
+// with a signed amount.
+func ledgerLines(entries []Entry) [][]string {
+	var lines [][]string
+	add := func(entry *Entry, direction string, asset Asset, sign float64) {
+		lines = append(lines, []string{
+			entry.Time.UTC().Format(time.RFC3339),
+			entry.TxHash,
+			entry.Wallet,
+			entry.Kind,
+			direction,
+			asset.Symbol,
+			asset.Mint,
+			formatAmount(sign * asset.Amount),
+			formatValue(sign * asset.Value),
+			entry.Counterparty,
+			entry.Description,
+		})
+	}
+	for i := range entries {
+		entry := &entries[i]
+		for _, asset := range entry.Sent {
+			add(entry, "out", asset, -1)
+		}
+		for _, asset := range entry.Received {
+			add(entry, "in", asset, 1)
+		}
+		if entry.Fee.Amount > 0 {
+			add(entry, "fee", entry.Fee, -1)
+		}
+	}
+	return lines
+}
+
+// netWorth is the USD value of a row: what was received, or what was sent
+// when the received side has no price.
+func netWorth(r row) float64 {
+	if r.received != nil && r.received.Value > 0 {
+		return r.received.Value
+	}
+	if r.sent != nil {
+		return r.sent.Value
+	}
+	return 0
+}
+
+func assetColumns(asset *Asset) (amount, currency string) {
+	if asset == nil || asset.Amount == 0 {
+		return "", ""
+	}
+	return formatAmount(asset.Amount), asset.Symbol
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
+
+func formatValue(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
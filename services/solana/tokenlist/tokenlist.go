@@ -0,0 +1,72 @@
+// Package tokenlist loads a list of verified tokens, such as Jupiter's strict
+// list, so legitimate tokens can be told apart from look-alikes.
+package tokenlist
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Token is an entry of a verified token list.
+type Token struct {
+	Address  string   `json:"address"`
+	Name     string   `json:"name"`
+	Symbol   string   `json:"symbol"`
+	Decimals int      `json:"decimals"`
+	LogoURI  string   `json:"logoURI"`
+	Tags     []string `json:"tags"`
+}
+
+// List is a set of verified tokens keyed by mint. The zero value and a nil
+// *List are empty lists.
+type List struct {
+	tokens map[string]Token
+}
+
+// Load reads a token list file. Both a bare JSON array of tokens, as served by
+// Jupiter, and the token-list standard's {"tokens": [...]} object are accepted.
+func Load(path string) (*List, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token list: %w", err)
+	}
+	var tokens []Token
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		var wrapped struct {
+			Tokens []Token `json:"tokens"`
+		}
+		if err := json.Unmarshal(data, &wrapped); err != nil {
+			return nil, fmt.Errorf("failed to parse token list: %w", err)
+		}
+		tokens = wrapped.Tokens
+	}
+	list := &List{tokens: make(map[string]Token, len(tokens))}
+	for _, token := range tokens {
+		list.tokens[token.Address] = token
+	}
+	return list, nil
+}
+
+// Lookup returns the list entry of a mint.
+func (l *List) Lookup(mint string) (Token, bool) {
+	if l == nil {
+		return Token{}, false
+	}
+	token, ok := l.tokens[mint]
+	return token, ok
+}
+
+// IsVerified reports whether a mint is on the list.
+func (l *List) IsVerified(mint string) bool {
+	_, ok := l.Lookup(mint)
+	return ok
+}
+
+// Len returns the number of tokens on the list.
+func (l *List) Len() int {
+	if l == nil {
+		return 0
+	}
+	return len(l.tokens)
+}
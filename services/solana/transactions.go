@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "solana/generated"
+	solana_requests "solana/requests/solana"
+)
+
+// fetchTransaction fetches and decodes one transaction, marking it failed
+// with the reason from its logs when it did. When the RPC rate limits the
+// request, retryAfter says how long to back off.
+func fetchTransaction(signature string) (tx *pb.Transaction, retryAfter time.Duration, err error) {
+	params := []interface{}{
+		signature,
+		map[string]interface{}{
+			"encoding":                       "json",
+			"maxSupportedTransactionVersion": 0,
+		},
+	}
+	result, err := solana_requests.QueryRPCWithRetry("getTransaction", params)
+	if err != nil {
+		var delaySeconds int
+		if n, _ := fmt.Sscanf(err.Error(), "retry after %d seconds", &delaySeconds); n == 1 {
+			return nil, time.Duration(delaySeconds) * time.Second, err
+		}
+		return nil, 0, err
+	}
+	var tmp map[string]interface{}
+	if err = json.Unmarshal([]byte(result), &tmp); err != nil {
+		return nil, 0, fmt.Errorf("error checking JSON for error field: %w", err)
+	}
+	if rpcErr, found := tmp["err"]; found {
+		return nil, 0, fmt.Errorf("RPC returned error: %v", rpcErr)
+	}
+	var txResponse pb.Transaction
+	opts := protojson.UnmarshalOptions{
+		DiscardUnknown: true,
+	}
+	if err = opts.Unmarshal([]byte(result), &txResponse); err != nil {
+		return nil, 0, fmt.Errorf("error unmarshalling transaction: %w", err)
+	}
+	// Failure is reported as meta.err, which the generated types leave out.
+	if meta := txResponse.GetResult().GetMeta(); meta != nil {
+		raw, _ := tmp["result"].(map[string]interface{})
+		rawMeta, _ := raw["meta"].(map[string]interface{})
+		meta.Status = transactionStatus(rawMeta["err"])
+		applyFailure(&txResponse)
+	}
+	return &txResponse, 0, nil
+}
+
+// maxFetchAttempts bounds how often one transaction is fetched before it is
+// given up on.
+const maxFetchAttempts = 5
+
+// fetchTransactions fetches the transactions of the signatures and calls
+// onFetched with each. Failed fetches go to the back of the queue and are
+// retried, up to maxFetchAttempts times each. It returns ctx.Err() as soon as
+// ctx is done, and otherwise an error naming the transactions that could not
+// be fetched once every other one has been passed to onFetched.
+func fetchTransactions(ctx context.Context, signatures []string, onFetched func(*pb.Transaction)) error {
+	queue := append([]string(nil), signatures...)
+	attempts := make(map[string]int)
+	var failed []string
+	for len(queue) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		signature := queue[0]
+		queue = queue[1:]
+		tx, retryAfter, err := fetchTransaction(signature)
+		if err != nil {
+			attempts[signature]++
+			if attempts[signature] >= maxFetchAttempts {
+				log.Error("giving up on transaction", "signature", signature, "attempts", attempts[signature], "error", err)
+				failed = append(failed, signature)
+				continue
+			}
+			delay := time.Second
+			if retryAfter > 0 {
+				log.Info("rate limited; retrying", "delaySeconds", int(retryAfter/time.Second), "signature", signature)
+				delay = retryAfter
+			} else {
+				log.Error("error fetching transaction", "signature", signature, "error", err)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(delay):
+			}
+			queue = append(queue, signature)
+			continue
+		}
+		onFetched(tx)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to fetch %d of %d transactions, including %s", len(failed), len(signatures), failed[0])
+	}
+	return nil
+}
+
+// transactionsError maps an error fetching transaction history to a gRPC
+// status: cancellation as such and anything else as the RPC being
+// unavailable.
+func transactionsError(err error) error {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	return status.Errorf(codes.Unavailable, "failed to fetch transactions: %v", err)
+}
+
+// transactionSignature returns the first signature of a transaction.
+func transactionSignature(tx *pb.Transaction) string {
+	if tx.Result == nil || tx.Result.Transaction == nil || len(tx.Result.Transaction.Signatures) == 0 {
+		return ""
+	}
+	return tx.Result.Transaction.Signatures[0]
+}
@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/log"
+
+	"solana/alerts"
+	pb "solana/generated"
+	coingecko_requests "solana/requests/coingecko"
+	solana_requests "solana/requests/solana"
+	solana_types "solana/types/solana_rpc"
+)
+
+// transfer is a watched wallet's net balance change of one asset in a
+// transaction. Amount is positive for funds received. SOL has no mint.
+type transfer struct {
+	mint   string
+	amount float64
+}
+
+// transferWatcher polls watched wallets for new transactions and notifies
+// of the transfers in them. It polls getSignaturesForAddress rather than
+// holding logsSubscribe websockets open per wallet, so notifications lag
+// by up to one interval.
+type transferWatcher struct {
+	server   *server
+	notifier *alerts.Notifier
+	interval time.Duration
+	// minValueUSD drops transfers worth less.
+	minValueUSD float64
+	// wallets are watched in addition to every portfolio wallet.
+	wallets    []string
+	webhookURL string
+	email      string
+	// latest is the newest signature seen per wallet.
+	latest map[string]string
+}
+
+// newTransferWatcher configures transfer notifications from the
+// environment: PULSE_WATCH_WALLETS (comma separated addresses or .sol
+// domains), PULSE_TRANSFER_INTERVAL (default 10s), PULSE_TRANSFER_MIN_USD,
+// PULSE_TRANSFER_WEBHOOK_URL and PULSE_TRANSFER_EMAIL.
+func newTransferWatcher(wallets *server, notifier *alerts.Notifier) (*transferWatcher, error) {
+	interval, err := time.ParseDuration(envOrDefault("PULSE_TRANSFER_INTERVAL", "10s"))
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid PULSE_TRANSFER_INTERVAL: %q", os.Getenv("PULSE_TRANSFER_INTERVAL"))
+	}
+	minValue, err := strconv.ParseFloat(envOrDefault("PULSE_TRANSFER_MIN_USD", "0"), 64)
+	if err != nil || minValue < 0 {
+		return nil, fmt.Errorf("invalid PULSE_TRANSFER_MIN_USD: %q", os.Getenv("PULSE_TRANSFER_MIN_USD"))
+	}
+	watcher := &transferWatcher{
+		server:      wallets,
+		notifier:    notifier,
+		interval:    interval,
+		minValueUSD: minValue,
+		webhookURL:  os.Getenv("PULSE_TRANSFER_WEBHOOK_URL"),
+		email:       os.Getenv("PULSE_TRANSFER_EMAIL"),
+		latest:      make(map[string]string),
+	}
+	for _, input := range strings.Split(os.Getenv("PULSE_WATCH_WALLETS"), ",") {
+		if strings.TrimSpace(input) == "" {
+			continue
+		}
+		wallet, err := resolveWalletAddress(input)
+		if err != nil {
+			return nil, fmt.Errorf("invalid wallet in PULSE_WATCH_WALLETS: %w", err)
+		}
+		watcher.wallets = append(watcher.wallets, wallet)
+	}
+	return watcher, nil
+}
+
+// Run polls until ctx is done.
+func (w *transferWatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		w.poll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll checks every watched wallet once. A wallet's first poll only
+// records its newest signature so history is not replayed as new; later
+// polls page back to the newest signature seen.
+func (w *transferWatcher) poll(ctx context.Context) {
+	for _, wallet := range w.watchedWallets(ctx) {
+		if ctx.Err() != nil {
+			return
+		}
+		latest, seen := w.latest[wallet]
+		var signatures []solana_types.WalletTransactionHashResponse
+		var err error
+		if seen {
+			var complete bool
+			signatures, complete, err = solana_requests.GetTransactionHashesAfter(wallet, latest)
+			if err == nil && !complete {
+				log.Warn("too many new signatures; older transfers are not notified", "wallet", wallet, "signatures", len(signatures))
+			}
+		} else {
+			signatures, err = solana_requests.GetTransactionHashesUntil(wallet, "", 1)
+		}
+		if err != nil {
+			log.Warn("failed to fetch new signatures", "wallet", wallet, "error", err)
+			continue
+		}
+		if len(signatures) == 0 {
+			if !seen {
+				w.latest[wallet] = ""
+			}
+			continue
+		}
+		w.latest[wallet] = signatures[0].Signature
+		if !seen {
+			continue
+		}
+		// Notify oldest first; failed transactions moved no funds.
+		var pending []string
+		for i := len(signatures) - 1; i >= 0; i-- {
+			if signatures[i].Err == nil {
+				pending = append(pending, signatures[i].Signature)
+			}
+		}
+		err = fetchTransactions(ctx, pending, func(tx *pb.Transaction) {
+			w.notify(ctx, wallet, tx)
+		})
+		if err != nil {
+			log.Warn("failed to fetch new transactions", "wallet", wallet, "error", err)
+		}
+	}
+}
+
+// watchedWallets returns the configured wallets and every portfolio
+// wallet, without duplicates.
+func (w *transferWatcher) watchedWallets(ctx context.Context) []string {
+	seen := make(map[string]bool)
+	var wallets []string
+	add := func(wallet string) {
+		if !seen[wallet] {
+			seen[wallet] = true
+			wallets = append(wallets, wallet)
+		}
+	}
+	for _, wallet := range w.wallets {
+		add(wallet)
+	}
+	if w.server.portfolios != nil {
+		portfolios, err := w.server.portfolios.Portfolios(ctx)
+		if err != nil {
+			log.Warn("failed to load portfolio wallets to watch", "error", err)
+		}
+		for _, portfolio := range portfolios {
+			for _, wallet := range portfolio.Wallets {
+				add(wallet.Address)
+			}
+		}
+	}
+	return wallets
+}
+
+// notify prices the wallet's transfers in a transaction and delivers those
+// worth at least the minimum value.
+func (w *transferWatcher) notify(ctx context.Context, wallet string, tx *pb.Transaction) {
+	transfers := walletTransfers(tx, wallet)
+	if len(transfers) == 0 {
+		return
+	}
+	prices, err := transferPrices(transfers)
+	if err != nil {
+		log.Warn("failed to price transfers", "wallet", wallet, "error", err)
+	}
+	var counterparty string
+	if others := counterparties(tx, map[string]bool{wallet: true}); len(others) > 0 {
+		counterparty = others[0]
+	}
+	now := time.Now().UTC()
+	for _, t := range transfers {
+		amount := math.Abs(t.amount)
+		value := amount * prices[t.mint]
+		if value < w.minValueUSD {
+			continue
+		}
+		alert := alerts.Alert{
+			Kind:         alerts.KindTransferIn,
+			Mint:         t.mint,
+			Wallet:       wallet,
+			Threshold:    w.minValueUSD,
+			Value:        value,
+			FiredAt:      now,
+			Signature:    transactionSignature(tx),
+			Amount:       amount,
+			Symbol:       w.server.assetSymbol(t.mint),
+			Counterparty: counterparty,
+		}
+		verb, preposition := "received", "from"
+		if t.amount < 0 {
+			alert.Kind = alerts.KindTransferOut
+			verb, preposition = "sent", "to"
+		}
+		other := counterparty
+		if other == "" {
+			other = "unknown"
+		}
+		alert.Message = fmt.Sprintf("%s %s %s %s ($%.2f) %s %s", wallet, verb,
+			strconv.FormatFloat(amount, 'f', -1, 64), alert.Symbol, value, preposition, other)
+		w.notifier.Deliver(ctx, alert, w.webhookURL, w.email)
+	}
+}
+
+// assetSymbol names an asset from the verified token list, falling back to
+// its mint. SOL has no mint.
+func (s *server) assetSymbol(mint string) string {
+	if mint == "" {
+		return "SOL"
+	}
+	if listed, ok := s.verified.Lookup(mint); ok {
+		return listed.Symbol
+	}
+	return mint
+}
+
+// walletTransfers returns a wallet's net SOL and token transfers in a
+// transaction. The fee is added back for the fee payer so paying it is not
+// reported as a transfer.
+func walletTransfers(tx *pb.Transaction, wallet string) []transfer {
+	changes := balanceChanges(tx, wallet)
+	message := tx.GetResult().GetTransaction().GetMessage()
+	if len(message.GetAccountKeys()) == 0 || message.AccountKeys[0] != wallet {
+		return changes
+	}
+	var transfers []transfer
+	for _, change := range changes {
+		if change.mint == "" {
+			change.amount += float64(tx.Result.Meta.Fee) / lamportsPerSol
+		}
+		if change.amount != 0 {
+			transfers = append(transfers, change)
+		}
+	}
+	return transfers
+}
+
+// balanceChanges returns how a transaction changed a wallet's SOL balance,
+// fee included, and its token balances, summed per mint. A failed
+// transaction only charged the fee payer its fee.
+func balanceChanges(tx *pb.Transaction, wallet string) []transfer {
+	if tx.Result == nil || tx.Result.Meta == nil || tx.Result.Transaction == nil || tx.Result.Transaction.Message == nil {
+		return nil
+	}
+	meta := tx.Result.Meta
+	if transactionFailed(tx) {
+		if keys := tx.Result.Transaction.Message.AccountKeys; len(keys) > 0 && keys[0] == wallet && meta.Fee > 0 {
+			return []transfer{{amount: -float64(meta.Fee) / lamportsPerSol}}
+		}
+		return nil
+	}
+	var changes []transfer
+	for i, key := range tx.Result.Transaction.Message.AccountKeys {
+		if key != wallet || i >= len(meta.PreBalances) || i >= len(meta.PostBalances) {
+			continue
+		}
+		if lamports := int64(meta.PostBalances[i]) - int64(meta.PreBalances[i]); lamports != 0 {
+			changes = append(changes, transfer{amount: float64(lamports) / lamportsPerSol})
+		}
+	}
+
+	deltas := make(map[string]float64)
+	var mints []string
+	addBalance := func(balance *pb.TokenBalance, sign float64) {
+		if balance.Owner != wallet || balance.UiTokenAmount == nil {
+			return
+		}
+		if _, ok := deltas[balance.Mint]; !ok {
+			mints = append(mints, balance.Mint)
+		}
+		deltas[balance.Mint] += sign * balance.UiTokenAmount.UiAmount
+	}
+	for _, balance := range meta.PreTokenBalances {
+		addBalance(balance, -1)
+	}
+	for _, balance := range meta.PostTokenBalances {
+		addBalance(balance, 1)
+	}
+	for _, mint := range mints {
+		if deltas[mint] != 0 {
+			changes = append(changes, transfer{mint: mint, amount: deltas[mint]})
+		}
+	}
+	return changes
+}
+
+// transferPrices returns the USD price of each transferred asset, keyed by
+// mint with SOL under the empty mint. Wrapped SOL is priced as SOL.
+func transferPrices(transfers []transfer) (map[string]float64, error) {
+	prices := make(map[string]float64)
+	var mints []string
+	needSol := false
+	for _, t := range transfers {
+		if t.mint == "" || t.mint == wrappedSolMint {
+			needSol = true
+		} else {
+			mints = append(mints, t.mint)
+		}
+	}
+	if needSol {
+		solanaPrice, err := coingecko_requests.GetSolanaPrice()
+		if err != nil {
+			return prices, err
+		}
+		prices[""] = solanaPrice
+		prices[wrappedSolMint] = solanaPrice
+	}
+	if len(mints) > 0 {
+		raw, err := coingecko_requests.GetCoinGeckoTokenPrices(mints)
+		if err != nil {
+			return prices, err
+		}
+		for mint, value := range raw {
+			prices[mint], _ = strconv.ParseFloat(value, 64)
+		}
+	}
+	return prices, nil
+}
@@ -82,3 +82,14 @@ type Reference struct {
 	ID   string `json:"id"`
 	Type string `json:"type"`
 }
+
+// RankedPool is a pool candidate for a token with the liquidity figures used
+// to rank it already parsed into numbers.
+type RankedPool struct {
+	Address      string
+	Name         string
+	Dex          string
+	QuoteToken   string
+	ReserveInUSD float64
+	VolumeH24USD float64
+}
@@ -0,0 +1,12 @@
+package marinade_types
+
+// APYResponse is returned by Marinade's /msol/apy endpoints. Value is the
+// annualized yield as a fraction, derived from the mSOL price change over
+// the requested window.
+type APYResponse struct {
+	Value      float64 `json:"value"`
+	StartTime  string  `json:"start_time"`
+	EndTime    string  `json:"end_time"`
+	StartPrice float64 `json:"start_price"`
+	EndPrice   float64 `json:"end_price"`
+}
@@ -0,0 +1,22 @@
+package offchain_types
+
+// TokenJSONMetadata is the off-chain JSON document a token's metadata uri
+// points at, following the Metaplex token metadata standard.
+type TokenJSONMetadata struct {
+	Name        string     `json:"name"`
+	Symbol      string     `json:"symbol"`
+	Description string     `json:"description"`
+	Image       string     `json:"image"`
+	ExternalURL string     `json:"external_url"`
+	Properties  Properties `json:"properties"`
+}
+
+type Properties struct {
+	Files    []File `json:"files"`
+	Category string `json:"category"`
+}
+
+type File struct {
+	URI  string `json:"uri"`
+	Type string `json:"type"`
+}
@@ -23,3 +23,55 @@ type GetAccountInfoValue struct {
 	RentEpoch  uint64 `json:"rentEpoch"`
 	Space      int64  `json:"space"`
 }
+
+// RawAccountInfoResponse is a getAccountInfo response requested with base64
+// encoding. Data holds the payload followed by the encoding name.
+type RawAccountInfoResponse struct {
+	JsonRPC string               `json:"jsonrpc"`
+	Result  RawAccountInfoResult `json:"result"`
+	Error   *SolanaError         `json:"error"`
+	Id      int16                `json:"id"`
+}
+
+type RawAccountInfoResult struct {
+	Context GetAccountInfoContext `json:"context"`
+	// Value is null when the account does not exist.
+	Value *RawAccountInfoValue `json:"value"`
+}
+
+type RawAccountInfoValue struct {
+	Data       []string `json:"data"`
+	Executable bool     `json:"executable"`
+	Lamports   int64    `json:"lamports"`
+	Owner      string   `json:"owner"`
+	RentEpoch  uint64   `json:"rentEpoch"`
+	Space      int64    `json:"space"`
+}
+
+// RawMultipleAccountsResponse is a getMultipleAccounts response requested
+// with base64 encoding. Accounts that do not exist are null.
+type RawMultipleAccountsResponse struct {
+	JsonRPC string                    `json:"jsonrpc"`
+	Result  RawMultipleAccountsResult `json:"result"`
+	Error   *SolanaError              `json:"error"`
+	Id      int64                     `json:"id"`
+}
+
+type RawMultipleAccountsResult struct {
+	Context GetAccountInfoContext  `json:"context"`
+	Value   []*RawAccountInfoValue `json:"value"`
+}
+
+// RawProgramAccountsResponse is a getProgramAccounts response requested
+// with base64 encoding.
+type RawProgramAccountsResponse struct {
+	JsonRPC string              `json:"jsonrpc"`
+	Result  []RawProgramAccount `json:"result"`
+	Error   *SolanaError        `json:"error"`
+	Id      int64               `json:"id"`
+}
+
+type RawProgramAccount struct {
+	Pubkey  string              `json:"pubkey"`
+	Account RawAccountInfoValue `json:"account"`
+}
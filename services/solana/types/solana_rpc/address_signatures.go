@@ -3,6 +3,7 @@ package solana_types
 type SignaturesForAddressResponse struct {
 	JsonRPC string                          `json:"jsonrpc"`
 	Result  []WalletTransactionHashResponse `json:"result"`
+	Error   *SolanaError                    `json:"error"`
 	Id      int64                           `json:"id"`
 }
 
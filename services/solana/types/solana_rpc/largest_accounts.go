@@ -0,0 +1,36 @@
+package solana_types
+
+type TokenLargestAccountsResponse struct {
+	JsonRPC string                     `json:"jsonrpc"`
+	Result  TokenLargestAccountsResult `json:"result"`
+	Error   *SolanaError               `json:"error"`
+	Id      int64                      `json:"id"`
+}
+
+type TokenLargestAccountsResult struct {
+	Context GetTokenAccountsByOwnerContext `json:"context"`
+	Value   []TokenLargestAccount          `json:"value"`
+}
+
+// TokenLargestAccount is one of the largest token accounts of a mint.
+type TokenLargestAccount struct {
+	Address        string  `json:"address"`
+	Amount         string  `json:"amount"`
+	Decimals       int     `json:"decimals"`
+	UIAmount       float64 `json:"uiAmount"`
+	UIAmountString string  `json:"uiAmountString"`
+}
+
+// MultipleAccountsResponse is a getMultipleAccounts response requested with
+// jsonParsed encoding. Accounts that do not exist are null.
+type MultipleAccountsResponse struct {
+	JsonRPC string                 `json:"jsonrpc"`
+	Result  MultipleAccountsResult `json:"result"`
+	Error   *SolanaError           `json:"error"`
+	Id      int64                  `json:"id"`
+}
+
+type MultipleAccountsResult struct {
+	Context GetTokenAccountsByOwnerContext `json:"context"`
+	Value   []*Account                     `json:"value"`
+}
@@ -0,0 +1,52 @@
+package solana_types
+
+// MintAccountResponse is a getAccountInfo response for a mint requested with
+// jsonParsed encoding.
+type MintAccountResponse struct {
+	JsonRPC string            `json:"jsonrpc"`
+	Result  MintAccountResult `json:"result"`
+	Error   *SolanaError      `json:"error"`
+	Id      int16             `json:"id"`
+}
+
+type MintAccountResult struct {
+	Context GetAccountInfoContext `json:"context"`
+	// Value is null when the mint does not exist.
+	Value *MintAccountValue `json:"value"`
+}
+
+type MintAccountValue struct {
+	Data     MintAccountData `json:"data"`
+	Lamports int64           `json:"lamports"`
+	Owner    string          `json:"owner"`
+	Space    int64           `json:"space"`
+}
+
+type MintAccountData struct {
+	Parsed  ParsedMint `json:"parsed"`
+	Program string     `json:"program"`
+}
+
+type ParsedMint struct {
+	Info MintInfo `json:"info"`
+	Type string   `json:"type"`
+}
+
+// MintInfo is the parsed state of an SPL or Token-2022 mint. Authorities are
+// null once they have been revoked.
+type MintInfo struct {
+	Decimals        int     `json:"decimals"`
+	FreezeAuthority *string `json:"freezeAuthority"`
+	MintAuthority   *string `json:"mintAuthority"`
+	IsInitialized   bool    `json:"isInitialized"`
+	Supply          string  `json:"supply"`
+	// Extensions is only present on Token-2022 mints.
+	Extensions []MintExtension `json:"extensions"`
+}
+
+// MintExtension is a parsed Token-2022 extension such as "permanentDelegate"
+// or "transferFeeConfig". State differs per extension.
+type MintExtension struct {
+	Extension string                 `json:"extension"`
+	State     map[string]interface{} `json:"state"`
+}
@@ -0,0 +1,95 @@
+package solana_types
+
+// StakeAccountsResponse is a getProgramAccounts response for the stake
+// program requested with jsonParsed encoding.
+type StakeAccountsResponse struct {
+	JsonRPC string         `json:"jsonrpc"`
+	Result  []StakeAccount `json:"result"`
+	Error   *SolanaError   `json:"error"`
+	Id      int64          `json:"id"`
+}
+
+type StakeAccount struct {
+	Pubkey  string              `json:"pubkey"`
+	Account StakeAccountDetails `json:"account"`
+}
+
+type StakeAccountDetails struct {
+	Data     StakeAccountData `json:"data"`
+	Lamports int64            `json:"lamports"`
+	Owner    string           `json:"owner"`
+}
+
+type StakeAccountData struct {
+	Parsed  ParsedStake `json:"parsed"`
+	Program string      `json:"program"`
+}
+
+// ParsedStake is "initialized" for undelegated accounts and "delegated"
+// once Stake is filled in.
+type ParsedStake struct {
+	Info StakeInfo `json:"info"`
+	Type string    `json:"type"`
+}
+
+type StakeInfo struct {
+	Meta  StakeMeta  `json:"meta"`
+	Stake *StakeData `json:"stake"`
+}
+
+type StakeMeta struct {
+	Authorized        StakeAuthorized `json:"authorized"`
+	RentExemptReserve string          `json:"rentExemptReserve"`
+}
+
+type StakeAuthorized struct {
+	Staker     string `json:"staker"`
+	Withdrawer string `json:"withdrawer"`
+}
+
+type StakeData struct {
+	Delegation      Delegation `json:"delegation"`
+	CreditsObserved uint64     `json:"creditsObserved"`
+}
+
+// Delegation amounts and epochs are strings since they can exceed 2^53.
+// A deactivation epoch of 2^64-1 means the stake is not deactivating.
+type Delegation struct {
+	Voter             string `json:"voter"`
+	Stake             string `json:"stake"`
+	ActivationEpoch   string `json:"activationEpoch"`
+	DeactivationEpoch string `json:"deactivationEpoch"`
+}
+
+type EpochInfoResponse struct {
+	JsonRPC string       `json:"jsonrpc"`
+	Result  EpochInfo    `json:"result"`
+	Error   *SolanaError `json:"error"`
+	Id      int64        `json:"id"`
+}
+
+type EpochInfo struct {
+	AbsoluteSlot     uint64 `json:"absoluteSlot"`
+	BlockHeight      uint64 `json:"blockHeight"`
+	Epoch            uint64 `json:"epoch"`
+	SlotIndex        uint64 `json:"slotIndex"`
+	SlotsInEpoch     uint64 `json:"slotsInEpoch"`
+	TransactionCount uint64 `json:"transactionCount"`
+}
+
+// InflationRewardResponse holds one entry per requested address, null for
+// addresses that earned nothing in the epoch.
+type InflationRewardResponse struct {
+	JsonRPC string             `json:"jsonrpc"`
+	Result  []*InflationReward `json:"result"`
+	Error   *SolanaError       `json:"error"`
+	Id      int64              `json:"id"`
+}
+
+type InflationReward struct {
+	Epoch         uint64 `json:"epoch"`
+	EffectiveSlot uint64 `json:"effectiveSlot"`
+	Amount        int64  `json:"amount"`
+	PostBalance   int64  `json:"postBalance"`
+	Commission    *int   `json:"commission"`
+}
@@ -3,6 +3,7 @@ package solana_types
 type GetTokenMetaDataResponse struct {
 	JSONRPC string        `json:"jsonrpc"`
 	Result  TokenMetaData `json:"result"`
+	Error   *SolanaError  `json:"error"`
 	ID      int           `json:"id"`
 }
 
@@ -12,7 +13,7 @@ type TokenMetaData struct {
 	Content     Content       `json:"content"`
 	Authorities []Authority   `json:"authorities"`
 	Compression Compression   `json:"compression"`
-	Grouping    []interface{} `json:"grouping"`
+	Grouping    []Grouping    `json:"grouping"`
 	Royalty     Royalty       `json:"royalty"`
 	Creators    []interface{} `json:"creators"`
 	Ownership   Ownership     `json:"ownership"`
@@ -28,6 +29,24 @@ type Content struct {
 	Links    Links    `json:"links"`
 }
 
+// Grouping links an asset to a group, usually its collection.
+type Grouping struct {
+	GroupKey   string `json:"group_key"`
+	GroupValue string `json:"group_value"`
+	// Verified is only reported when unverified collections were requested;
+	// nil means the collection is verified, as DAS hides the others by default.
+	Verified           *bool               `json:"verified"`
+	CollectionMetadata *CollectionMetadata `json:"collection_metadata"`
+}
+
+// CollectionMetadata is only present when collection metadata was requested.
+type CollectionMetadata struct {
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Image       string `json:"image"`
+	Description string `json:"description"`
+}
+
 type File struct {
 	URI  string `json:"uri"`
 	Mime string `json:"mime"`
@@ -76,3 +95,31 @@ type Ownership struct {
 	OwnershipModel string  `json:"ownership_model"`
 	Owner          string  `json:"owner"`
 }
+
+// OnChainMetadata is the subset of a Metaplex metadata account, or a
+// Token-2022 metadata extension, that we decode ourselves.
+type OnChainMetadata struct {
+	UpdateAuthority string
+	Mint            string
+	Name            string
+	Symbol          string
+	URI             string
+	// Collection is the collection mint, empty when the metadata has none.
+	Collection         string
+	CollectionVerified bool
+}
+
+// AssetsByOwnerResponse is a page of the DAS getAssetsByOwner method.
+type AssetsByOwnerResponse struct {
+	JSONRPC string       `json:"jsonrpc"`
+	Result  AssetsPage   `json:"result"`
+	Error   *SolanaError `json:"error"`
+	ID      int          `json:"id"`
+}
+
+type AssetsPage struct {
+	Total int             `json:"total"`
+	Limit int             `json:"limit"`
+	Page  int             `json:"page"`
+	Items []TokenMetaData `json:"items"`
+}
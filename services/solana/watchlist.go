@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "solana/generated"
+	"solana/watchlist"
+)
+
+// watchlistServer implements the WatchlistService on a portfolio store.
+type watchlistServer struct {
+	pb.UnimplementedWatchlistServiceServer
+	store *watchlist.Store
+}
+
+// errPortfoliosDisabled is returned when no database is configured.
+var errPortfoliosDisabled = status.Errorf(codes.FailedPrecondition, "portfolios are not configured")
+
+func (w *watchlistServer) CreatePortfolio(ctx context.Context, req *pb.CreatePortfolioRequest) (*pb.Portfolio, error) {
+	if w.store == nil {
+		return nil, errPortfoliosDisabled
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "portfolio name is required")
+	}
+	portfolio, err := w.store.CreatePortfolio(ctx, name)
+	if err != nil {
+		return nil, portfolioError(err)
+	}
+	return toPbPortfolio(portfolio), nil
+}
+
+func (w *watchlistServer) RenamePortfolio(ctx context.Context, req *pb.RenamePortfolioRequest) (*pb.Portfolio, error) {
+	if w.store == nil {
+		return nil, errPortfoliosDisabled
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "portfolio name is required")
+	}
+	portfolio, err := w.store.RenamePortfolio(ctx, req.Id, name)
+	if err != nil {
+		return nil, portfolioError(err)
+	}
+	return toPbPortfolio(portfolio), nil
+}
+
+func (w *watchlistServer) DeletePortfolio(ctx context.Context, req *pb.PortfolioRequest) (*pb.DeletePortfolioResponse, error) {
+	if w.store == nil {
+		return nil, errPortfoliosDisabled
+	}
+	if err := w.store.DeletePortfolio(ctx, req.Id); err != nil {
+		return nil, portfolioError(err)
+	}
+	return &pb.DeletePortfolioResponse{}, nil
+}
+
+func (w *watchlistServer) GetPortfolio(ctx context.Context, req *pb.PortfolioRequest) (*pb.Portfolio, error) {
+	if w.store == nil {
+		return nil, errPortfoliosDisabled
+	}
+	portfolio, err := w.store.Portfolio(ctx, req.Id)
+	if err != nil {
+		return nil, portfolioError(err)
+	}
+	return toPbPortfolio(portfolio), nil
+}
+
+func (w *watchlistServer) ListPortfolios(ctx context.Context, req *pb.ListPortfoliosRequest) (*pb.ListPortfoliosResponse, error) {
+	if w.store == nil {
+		return nil, errPortfoliosDisabled
+	}
+	portfolios, err := w.store.Portfolios(ctx)
+	if err != nil {
+		return nil, portfolioError(err)
+	}
+	response := &pb.ListPortfoliosResponse{}
+	for _, portfolio := range portfolios {
+		response.Portfolios = append(response.Portfolios, toPbPortfolio(portfolio))
+	}
+	return response, nil
+}
+
+func (w *watchlistServer) AddPortfolioWallet(ctx context.Context, req *pb.AddPortfolioWalletRequest) (*pb.Portfolio, error) {
+	if w.store == nil {
+		return nil, errPortfoliosDisabled
+	}
+	if req.Wallet == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "wallet is required")
+	}
+	address, err := resolveWalletAddress(req.Wallet.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid wallet address: %v", err)
+	}
+	err = w.store.AddWallet(ctx, req.PortfolioId, watchlist.Wallet{
+		Address: address,
+		Label:   strings.TrimSpace(req.Wallet.Label),
+		Tags:    req.Wallet.Tags,
+		Owned:   req.Wallet.Owned,
+	})
+	if err != nil {
+		return nil, portfolioError(err)
+	}
+	return w.GetPortfolio(ctx, &pb.PortfolioRequest{Id: req.PortfolioId})
+}
+
+func (w *watchlistServer) RemovePortfolioWallet(ctx context.Context, req *pb.RemovePortfolioWalletRequest) (*pb.Portfolio, error) {
+	if w.store == nil {
+		return nil, errPortfoliosDisabled
+	}
+	address, err := resolveWalletAddress(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid wallet address: %v", err)
+	}
+	if err := w.store.RemoveWallet(ctx, req.PortfolioId, address); err != nil {
+		return nil, portfolioError(err)
+	}
+	return w.GetPortfolio(ctx, &pb.PortfolioRequest{Id: req.PortfolioId})
+}
+
+// portfolioError maps store errors to gRPC statuses.
+func portfolioError(err error) error {
+	if errors.Is(err, watchlist.ErrNotFound) {
+		return status.Errorf(codes.NotFound, "%v", err)
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
+
+func toPbPortfolio(portfolio watchlist.Portfolio) *pb.Portfolio {
+	result := &pb.Portfolio{
+		Id:        portfolio.ID,
+		Name:      portfolio.Name,
+		CreatedAt: portfolio.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, wallet := range portfolio.Wallets {
+		result.Wallets = append(result.Wallets, &pb.PortfolioWallet{
+			Address: wallet.Address,
+			Label:   wallet.Label,
+			Tags:    wallet.Tags,
+			Owned:   wallet.Owned,
+		})
+	}
+	return result
+}
@@ -0,0 +1,202 @@
+// Package watchlist persists portfolios: named groups of wallets with
+// labels and tags, each marked as owned or merely watched.
+package watchlist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ErrNotFound is returned for portfolios that do not exist.
+var ErrNotFound = errors.New("portfolio not found")
+
+const schema = `
+CREATE TABLE IF NOT EXISTS portfolios (
+	id         BIGSERIAL PRIMARY KEY,
+	name       TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE TABLE IF NOT EXISTS portfolio_wallets (
+	portfolio_id BIGINT NOT NULL REFERENCES portfolios(id) ON DELETE CASCADE,
+	address      TEXT NOT NULL,
+	label        TEXT NOT NULL DEFAULT '',
+	tags         TEXT[] NOT NULL DEFAULT '{}',
+	owned        BOOLEAN NOT NULL DEFAULT TRUE,
+	added_at     TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (portfolio_id, address)
+);`
+
+// Portfolio is a named group of wallets.
+type Portfolio struct {
+	ID        int64
+	Name      string
+	CreatedAt time.Time
+	Wallets   []Wallet
+}
+
+// Wallet is a wallet in a portfolio. Owned wallets count as the
+// portfolio's own when detecting internal transfers; watched ones do not.
+type Wallet struct {
+	Address string
+	Label   string
+	Tags    []string
+	Owned   bool
+}
+
+// Store reads and writes portfolios in Postgres.
+type Store struct {
+	db *sql.DB
+}
+
+// New creates the portfolio tables if needed and returns a store using db.
+func New(db *sql.DB) (*Store, error) {
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create portfolio tables: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// CreatePortfolio creates an empty portfolio.
+func (s *Store) CreatePortfolio(ctx context.Context, name string) (Portfolio, error) {
+	portfolio := Portfolio{Name: name}
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO portfolios (name) VALUES ($1) RETURNING id, created_at`, name,
+	).Scan(&portfolio.ID, &portfolio.CreatedAt)
+	if err != nil {
+		return Portfolio{}, fmt.Errorf("failed to create portfolio: %w", err)
+	}
+	return portfolio, nil
+}
+
+// RenamePortfolio renames a portfolio.
+func (s *Store) RenamePortfolio(ctx context.Context, id int64, name string) (Portfolio, error) {
+	result, err := s.db.ExecContext(ctx, `UPDATE portfolios SET name = $2 WHERE id = $1`, id, name)
+	if err := checkAffected(result, err); err != nil {
+		return Portfolio{}, err
+	}
+	return s.Portfolio(ctx, id)
+}
+
+// DeletePortfolio deletes a portfolio and its wallets.
+func (s *Store) DeletePortfolio(ctx context.Context, id int64) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM portfolios WHERE id = $1`, id)
+	return checkAffected(result, err)
+}
+
+// Portfolio returns a portfolio with its wallets.
+func (s *Store) Portfolio(ctx context.Context, id int64) (Portfolio, error) {
+	portfolio := Portfolio{ID: id}
+	err := s.db.QueryRowContext(ctx,
+		`SELECT name, created_at FROM portfolios WHERE id = $1`, id,
+	).Scan(&portfolio.Name, &portfolio.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Portfolio{}, ErrNotFound
+	}
+	if err != nil {
+		return Portfolio{}, fmt.Errorf("failed to read portfolio: %w", err)
+	}
+	portfolio.Wallets, err = s.wallets(ctx, id)
+	if err != nil {
+		return Portfolio{}, err
+	}
+	return portfolio, nil
+}
+
+// Portfolios returns every portfolio with its wallets, oldest first.
+func (s *Store) Portfolios(ctx context.Context) ([]Portfolio, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, name, created_at FROM portfolios ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list portfolios: %w", err)
+	}
+	var portfolios []Portfolio
+	for rows.Next() {
+		var portfolio Portfolio
+		if err := rows.Scan(&portfolio.ID, &portfolio.Name, &portfolio.CreatedAt); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to read portfolio: %w", err)
+		}
+		portfolios = append(portfolios, portfolio)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list portfolios: %w", err)
+	}
+	for i := range portfolios {
+		portfolios[i].Wallets, err = s.wallets(ctx, portfolios[i].ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return portfolios, nil
+}
+
+// AddWallet adds a wallet to a portfolio, or updates its label, tags and
+// ownership if it is already there.
+func (s *Store) AddWallet(ctx context.Context, portfolioID int64, wallet Wallet) error {
+	tags := wallet.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO portfolio_wallets (portfolio_id, address, label, tags, owned)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (portfolio_id, address)
+		DO UPDATE SET label = EXCLUDED.label, tags = EXCLUDED.tags, owned = EXCLUDED.owned`,
+		portfolioID, wallet.Address, wallet.Label, pq.Array(tags), wallet.Owned,
+	)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code.Name() == "foreign_key_violation" {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add wallet: %w", err)
+	}
+	return nil
+}
+
+// RemoveWallet removes a wallet from a portfolio, or returns ErrNotFound
+// when the portfolio does not hold it.
+func (s *Store) RemoveWallet(ctx context.Context, portfolioID int64, address string) error {
+	result, err := s.db.ExecContext(ctx,
+		`DELETE FROM portfolio_wallets WHERE portfolio_id = $1 AND address = $2`, portfolioID, address)
+	return checkAffected(result, err)
+}
+
+func (s *Store) wallets(ctx context.Context, portfolioID int64) ([]Wallet, error) {
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT address, label, tags, owned FROM portfolio_wallets
+		WHERE portfolio_id = $1 ORDER BY added_at, address`, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list wallets: %w", err)
+	}
+	defer rows.Close()
+	var wallets []Wallet
+	for rows.Next() {
+		var wallet Wallet
+		if err := rows.Scan(&wallet.Address, &wallet.Label, pq.Array(&wallet.Tags), &wallet.Owned); err != nil {
+			return nil, fmt.Errorf("failed to read wallet: %w", err)
+		}
+		wallets = append(wallets, wallet)
+	}
+	return wallets, rows.Err()
+}
+
+// checkAffected turns an update that matched no rows into ErrNotFound.
+func checkAffected(result sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}